@@ -0,0 +1,342 @@
+package xmath
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigComplex is an arbitrary-precision complex number
+// with big.Float parts.
+//
+// Like big.Float, BigComplex operations use the precision and
+// the rounding mode of the receiver; if the receiver precision
+// is 0, it's set to the largest precision of the operands first.
+// Both parts share the same precision and rounding mode.
+// The zero value is 0 with precision 0.
+//
+// Operations that produce NaN parts panic with big.ErrNaN.
+type BigComplex struct {
+	re big.Float
+	im big.Float
+}
+
+// bigGuardBits is the number of extra bits used by
+// intermediate computations.
+const bigGuardBits = 64
+
+// NewBigComplex returns a new BigComplex set to complex(re, im)
+// with precision 53 and rounding mode big.ToNearestEven.
+// It panics with big.ErrNaN if re or im is NaN.
+func NewBigComplex(re, im float64) *BigComplex {
+	var z BigComplex
+	z.re.SetFloat64(re)
+	z.im.SetFloat64(im)
+	return &z
+}
+
+// SetPrec sets the precision of z to prec and returns the
+// (possibly) rounded value of z.
+func (z *BigComplex) SetPrec(prec uint) *BigComplex {
+	z.re.SetPrec(prec)
+	z.im.SetPrec(prec)
+	return z
+}
+
+// SetMode sets the rounding mode of z to mode and returns z.
+// z's value is not changed.
+func (z *BigComplex) SetMode(mode big.RoundingMode) *BigComplex {
+	z.re.SetMode(mode)
+	z.im.SetMode(mode)
+	return z
+}
+
+// Prec returns the precision of z in bits.
+func (z *BigComplex) Prec() uint { return z.re.Prec() }
+
+// Mode returns the rounding mode of z.
+func (z *BigComplex) Mode() big.RoundingMode { return z.re.Mode() }
+
+// Real returns z real part. The result shares storage with z.
+func (z *BigComplex) Real() *big.Float { return &z.re }
+
+// Imag returns z imaginary part. The result shares storage with z.
+func (z *BigComplex) Imag() *big.Float { return &z.im }
+
+// Set sets z to the (possibly rounded) value of x and returns z.
+func (z *BigComplex) Set(x *BigComplex) *BigComplex {
+	z.prepare(x, x)
+	z.re.Set(&x.re)
+	z.im.Set(&x.im)
+	return z
+}
+
+// SetParts sets z to complex(re, im), rounded to z precision.
+func (z *BigComplex) SetParts(re, im *big.Float) *BigComplex {
+	if z.Prec() == 0 {
+		z.SetPrec(max(re.Prec(), im.Prec()))
+	}
+	z.re.Set(re)
+	z.im.Set(im)
+	return z
+}
+
+// SetComplex64 sets z to the (possibly rounded) value of c.
+// If z precision is 0, it's set to 24.
+// It panics with big.ErrNaN if c has NaN parts.
+func (z *BigComplex) SetComplex64(c Complex64) *BigComplex {
+	if z.Prec() == 0 {
+		z.SetPrec(24)
+	}
+	z.re.SetFloat64(float64(c.r))
+	z.im.SetFloat64(float64(c.i))
+	return z
+}
+
+// SetComplex128 sets z to the (possibly rounded) value of c.
+// If z precision is 0, it's set to 53.
+// It panics with big.ErrNaN if c has NaN parts.
+func (z *BigComplex) SetComplex128(c Complex128) *BigComplex {
+	z.re.SetFloat64(c.r)
+	z.im.SetFloat64(c.i)
+	return z
+}
+
+// Complex64 returns x rounded to Complex64.
+// Every part is rounded once, using x rounding mode;
+// values that are too large are converted to infinities.
+func (x *BigComplex) Complex64() Complex64 {
+	r, _ := roundBig(&x.re, 24, -149).Float32()
+	i, _ := roundBig(&x.im, 24, -149).Float32()
+	return Complex64{r: r, i: i}
+}
+
+// Complex128 returns x rounded to Complex128.
+// Every part is rounded once, using x rounding mode;
+// values that are too large are converted to infinities.
+func (x *BigComplex) Complex128() Complex128 {
+	r, _ := roundBig(&x.re, 53, -1074).Float64()
+	i, _ := roundBig(&x.im, 53, -1074).Float64()
+	return Complex128{r: r, i: i}
+}
+
+// roundBig returns x rounded with x rounding mode to a float type
+// with prec bits of mantissa and 2^minExp smallest subnormal value.
+// big.Float conversions to float types always round to nearest even,
+// so other modes are applied beforehand; the result is exact in that type.
+func roundBig(x *big.Float, prec, minExp int) *big.Float {
+	mode := x.Mode()
+	if mode == big.ToNearestEven || x.IsInf() || x.Sign() == 0 {
+		return x
+	}
+	// Subnormal values have less significant bits: x is in
+	// [2^(e-1), 2^e) range, its last bit can't be below 2^minExp.
+	p := min(prec, x.MantExp(nil)-minExp)
+	if p > 0 {
+		return newBigFloat(uint(p)).SetMode(mode).Set(x)
+	}
+	// |x| < 2^minExp, so it's rounded either to zero or to the
+	// smallest subnormal. For p == 0, |x| is at least a half of it.
+	neg := x.Signbit()
+	up := false
+	switch mode {
+	case big.AwayFromZero:
+		up = true
+	case big.ToNearestAway:
+		up = p == 0
+	case big.ToPositiveInf:
+		up = !neg
+	case big.ToNegativeInf:
+		up = neg
+	}
+	z := new(big.Float)
+	if up {
+		z.SetMantExp(big.NewFloat(0.5), minExp+1)
+	}
+	if neg {
+		z.Neg(z)
+	}
+	return z
+}
+
+// Add sets z to the rounded sum x+y and returns z.
+func (z *BigComplex) Add(x, y *BigComplex) *BigComplex {
+	z.prepare(x, y)
+	z.re.Add(&x.re, &y.re)
+	z.im.Add(&x.im, &y.im)
+	return z
+}
+
+// Sub sets z to the rounded difference x-y and returns z.
+func (z *BigComplex) Sub(x, y *BigComplex) *BigComplex {
+	z.prepare(x, y)
+	z.re.Sub(&x.re, &y.re)
+	z.im.Sub(&x.im, &y.im)
+	return z
+}
+
+// Mul sets z to the rounded product x*y and returns z.
+//
+// Every part is correctly rounded: the products are computed
+// exactly and rounded only once, by the final addition.
+func (z *BigComplex) Mul(x, y *BigComplex) *BigComplex {
+	z.prepare(x, y)
+	prec := x.Prec() + y.Prec()
+	ac := newBigFloat(prec).Mul(&x.re, &y.re)
+	bd := newBigFloat(prec).Mul(&x.im, &y.im)
+	ad := newBigFloat(prec).Mul(&x.re, &y.im)
+	bc := newBigFloat(prec).Mul(&x.im, &y.re)
+	z.re.Sub(ac, bd)
+	z.im.Add(ad, bc)
+	return z
+}
+
+// Quo sets z to the rounded quotient x/y and returns z.
+//
+// The numerator x*conj(y) and the denominator |y|^2 are computed
+// with extra precision before the final division.
+// It panics with big.ErrNaN if y is zero.
+func (z *BigComplex) Quo(x, y *BigComplex) *BigComplex {
+	if y.re.Sign() == 0 && y.im.Sign() == 0 {
+		// ErrNaN message can't be set outside of math/big,
+		// so let big.Float raise the panic for 0/0.
+		new(big.Float).Quo(&y.re, &y.im)
+	}
+	z.prepare(x, y)
+	wp := z.Prec() + x.Prec() + y.Prec() + bigGuardBits
+	num := new(BigComplex).SetPrec(wp).Mul(x, new(BigComplex).Set(y).conj())
+	den := newBigFloat(wp).Mul(&y.re, &y.re)
+	den.Add(den, newBigFloat(wp).Mul(&y.im, &y.im))
+	z.re.Quo(&num.re, den)
+	z.im.Quo(&num.im, den)
+	return z
+}
+
+// Abs returns the absolute value of x with x precision.
+func (x *BigComplex) Abs() *big.Float {
+	wp := 2*x.Prec() + bigGuardBits
+	sum := newBigFloat(wp).Mul(&x.re, &x.re)
+	sum.Add(sum, newBigFloat(wp).Mul(&x.im, &x.im))
+	return newBigFloat(x.Prec()).SetMode(x.Mode()).Sqrt(sum)
+}
+
+// Sqrt sets z to the rounded principal square root of x and returns z.
+// The result real part is non-negative, the imaginary part
+// has the sign of x imaginary part.
+func (z *BigComplex) Sqrt(x *BigComplex) *BigComplex {
+	z.prepare(x, x)
+	if x.re.Sign() == 0 && x.im.Sign() == 0 {
+		z.re.SetInt64(0)
+		z.im.Set(&x.im)
+		return z
+	}
+	wp := z.Prec() + bigGuardBits
+	abs := new(BigComplex).SetPrec(wp).Set(x).Abs()
+
+	// t = sqrt((|x| + |re|) / 2) avoids cancellation;
+	// the other part is im / (2*t).
+	t := newBigFloat(wp).Abs(&x.re)
+	t.Add(t, abs)
+	t.SetMantExp(t, -1)
+	t.Sqrt(t)
+	other := newBigFloat(wp).Quo(&x.im, t)
+	other.SetMantExp(other, -1)
+	if x.re.Sign() >= 0 {
+		z.re.Set(t)
+		z.im.Set(other)
+		return z
+	}
+	if x.im.Signbit() {
+		t.Neg(t)
+	}
+	z.re.Abs(other)
+	z.im.Set(t)
+	return z
+}
+
+// Exp sets z to the rounded value of e^x and returns z.
+// It panics with big.ErrNaN if x imaginary part is infinite.
+func (z *BigComplex) Exp(x *BigComplex) *BigComplex {
+	z.prepare(x, x)
+	wp := z.Prec() + bigGuardBits
+	mag := bigExp(&x.re, wp)
+	if x.im.Sign() == 0 {
+		z.re.Set(mag)
+		z.im.Set(&x.im)
+		return z
+	}
+	sin, cos := bigSinCos(&x.im, wp)
+	z.re.Mul(mag, cos)
+	z.im.Mul(mag, sin)
+	return z
+}
+
+// Log sets z to the rounded principal value of the natural
+// logarithm of x and returns z.
+// The imaginary part is in the range [-Pi, Pi].
+// Log(0) has negative infinite real part.
+func (z *BigComplex) Log(x *BigComplex) *BigComplex {
+	z.prepare(x, x)
+	wp := z.Prec() + bigGuardBits
+	im := bigAtan2(&x.im, &x.re, wp)
+
+	// ln|x| = ln(re^2 + im^2) / 2. The sum is exact while the
+	// exponents of the squares differ by at most 4*wp, so there is
+	// no cancellation for |x| close to 1. Beyond that gap the smaller
+	// square doesn't change the logarithm at precision wp.
+	sumPrec := 2*max(x.re.Prec(), x.im.Prec()) + wp
+	if x.re.Sign() != 0 && x.im.Sign() != 0 {
+		diff := x.re.MantExp(nil) - x.im.MantExp(nil)
+		sumPrec += uint(min(2*max(diff, -diff), 4*int(wp)))
+	}
+	sum := newBigFloat(sumPrec).Mul(&x.re, &x.re)
+	sum.Add(sum, newBigFloat(sumPrec).Mul(&x.im, &x.im))
+	z.re.Set(bigLog(sum, wp))
+	z.re.SetMantExp(&z.re, -1)
+	z.im.Set(im)
+	return z
+}
+
+// Format implements fmt.Formatter.
+//
+// Parts are formatted like big.Float formats them and combined
+// like fmt prints builtin complex numbers: "(re+imi)".
+// Width and flags are applied to every part.
+func (x *BigComplex) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v', 'e', 'E', 'f', 'F', 'g', 'G', 'b', 'p':
+	default:
+		fmt.Fprintf(s, "%%!%c(*xmath.BigComplex=%s)", verb, x.String())
+		return
+	}
+	format := fmt.FormatString(s, verb)
+	plusFormat := format
+	if !s.Flag('+') {
+		plusFormat = "%+" + format[1:]
+	}
+	fmt.Fprintf(s, "("+format+plusFormat+"i)", &x.re, &x.im)
+}
+
+// String formats x like fmt prints complex128 values with %v:
+// every part uses the shortest decimal representation
+// that round-trips at x precision.
+func (x *BigComplex) String() string {
+	return fmt.Sprintf("%v", x)
+}
+
+// prepare sets z precision to the largest precision
+// of x and y if z precision is 0.
+func (z *BigComplex) prepare(x, y *BigComplex) {
+	if z.Prec() == 0 {
+		z.SetPrec(max(x.Prec(), y.Prec()))
+	}
+}
+
+// conj negates z imaginary part and returns z.
+func (z *BigComplex) conj() *BigComplex {
+	z.im.Neg(&z.im)
+	return z
+}
+
+func newBigFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec)
+}
@@ -0,0 +1,375 @@
+package xmath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+)
+
+// Helper functions.
+
+// ttBigPrec is large enough to represent the exact product
+// of any two Complex64 values.
+const ttBigPrec = 1000
+
+func ttBig64(c Complex64) *BigComplex {
+	return new(BigComplex).SetPrec(ttBigPrec).SetComplex64(c)
+}
+
+func ttBig128(c complex128) *BigComplex {
+	return new(BigComplex).SetComplex128(Complex128{r: real(c), i: imag(c)})
+}
+
+func ttIsFinite64(c Complex64) bool {
+	return isFinite(float64(c.r)) && isFinite(float64(c.i))
+}
+
+// ttRelError128 returns |have - want| / |want|.
+func ttRelError128(have Complex128, want complex128) float64 {
+	d := complex(have.r, have.i) - want
+	if want == 0 {
+		return cmplx.Abs(d)
+	}
+	return cmplx.Abs(d) / cmplx.Abs(want)
+}
+
+// ttBigValues128 are complex128 values with moderate magnitudes.
+var ttBigValues128 = []complex128{
+	1, -1, 1i, -1i, 2 + 3i, -2.5 + 0.5i, 0.1 - 0.7i, -3 - 4i,
+	1e-5 + 2e-5i, 12 - 0.001i, -0.75 + 9i, 1e3 + 1e-3i,
+	complex(math.Pi, -math.E), complex(-math.Sqrt2, math.Ln2),
+}
+
+// Unit tests.
+
+func TestBigComplexMul64(t *testing.T) {
+	for _, v := range ttValues {
+		x, y := ttUnpack64(v)
+		if !ttIsFinite64(x) || !ttIsFinite64(y) {
+			continue
+		}
+		want := new(BigComplex).SetPrec(ttBigPrec).Mul(ttBig64(x), ttBig64(y)).Complex64()
+		if have := x.Mul(y); !have.Eq(want) {
+			t.Errorf("`%v*%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+		}
+	}
+}
+
+func TestBigComplexAddSub64(t *testing.T) {
+	for _, v := range ttValues {
+		x, y := ttUnpack64(v)
+		if !ttIsFinite64(x) || !ttIsFinite64(y) {
+			continue
+		}
+		want := new(BigComplex).SetPrec(ttBigPrec).Add(ttBig64(x), ttBig64(y)).Complex64()
+		if have := x.Add(y); !have.Eq(want) {
+			t.Errorf("`%v+%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+		}
+		want = new(BigComplex).SetPrec(ttBigPrec).Sub(ttBig64(x), ttBig64(y)).Complex64()
+		if have := x.Sub(y); !have.Eq(want) {
+			t.Errorf("`%v-%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+		}
+	}
+}
+
+func TestBigComplexQuo64(t *testing.T) {
+	for _, v := range ttValues {
+		x, y := ttUnpack64(v)
+		if !ttIsFinite64(x) || !ttIsFinite64(y) || y.IsZero() {
+			continue
+		}
+		want := new(BigComplex).SetPrec(ttBigPrec).Quo(ttBig64(x), ttBig64(y)).Complex128()
+		have := x.Div(y)
+		if math.IsInf(want.r, 0) || math.IsInf(want.i, 0) || want.Complex64().Abs() > math.MaxFloat32/2 {
+			continue
+		}
+		// Smith's algorithm is not correctly rounded,
+		// but it's accurate in the normwise sense.
+		if err := ttRelError128(have.Complex128(), complex(want.r, want.i)); err > 0x1p-22 {
+			t.Errorf("`%v/%v` failed;\nwant: %v\nhave: %v", x, y, want.Complex64(), have)
+		}
+	}
+}
+
+func TestBigComplexArith128(t *testing.T) {
+	for _, x := range ttBigValues128 {
+		for _, y := range ttBigValues128 {
+			bx, by := ttBig128(x), ttBig128(y)
+			tests := []struct {
+				name string
+				want complex128
+				have *BigComplex
+				tol  float64
+			}{
+				{"+", x + y, new(BigComplex).Add(bx, by), 0},
+				{"-", x - y, new(BigComplex).Sub(bx, by), 0},
+				{"*", x * y, new(BigComplex).Mul(bx, by), 0x1p-51},
+				{"/", x / y, new(BigComplex).Quo(bx, by), 0x1p-50},
+			}
+			for _, tt := range tests {
+				if tt.have.Prec() != 53 {
+					t.Errorf("`%v%s%v`: want 53 bits precision, have %d", x, tt.name, y, tt.have.Prec())
+				}
+				if err := ttRelError128(tt.have.Complex128(), tt.want); err > tt.tol {
+					t.Errorf("`%v%s%v` failed;\nwant: %v\nhave: %v", x, tt.name, y, tt.want, tt.have)
+				}
+			}
+		}
+	}
+}
+
+func TestBigComplexFuncs(t *testing.T) {
+	tests := []struct {
+		name    string
+		builtin func(complex128) complex128
+		op      func(z, x *BigComplex) *BigComplex
+	}{
+		{"sqrt", cmplx.Sqrt, (*BigComplex).Sqrt},
+		{"exp", cmplx.Exp, (*BigComplex).Exp},
+		{"log", cmplx.Log, (*BigComplex).Log},
+	}
+	for _, tt := range tests {
+		for _, x := range ttBigValues128 {
+			want := tt.builtin(x)
+			have := tt.op(new(BigComplex), ttBig128(x))
+			if have.Prec() != 53 {
+				t.Errorf("`%s(%v)`: want 53 bits precision, have %d", tt.name, x, have.Prec())
+			}
+			if err := ttRelError128(have.Complex128(), want); err > 1e-15 {
+				t.Errorf("`%s(%v)` failed;\nwant: %v\nhave: %v", tt.name, x, want, have)
+			}
+		}
+	}
+
+	for _, x := range ttBigValues128 {
+		want := cmplx.Abs(x)
+		have, _ := ttBig128(x).Abs().Float64()
+		if math.Abs(have-want) > 1e-15*want {
+			t.Errorf("`abs(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
+func TestBigComplexFuncsSpecial(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	tests := []struct {
+		name string
+		op   func(z, x *BigComplex) *BigComplex
+		x    complex128
+		want complex128
+	}{
+		{"sqrt", (*BigComplex).Sqrt, 0, 0},
+		{"sqrt", (*BigComplex).Sqrt, -4, 2i},
+		{"sqrt", (*BigComplex).Sqrt, complex(-4, negZero), -2i},
+		{"sqrt", (*BigComplex).Sqrt, 2i, 1 + 1i},
+		{"exp", (*BigComplex).Exp, 0, 1},
+		{"exp", (*BigComplex).Exp, complex(-1000, negZero), complex(0, negZero)},
+		{"log", (*BigComplex).Log, 1, 0},
+		{"log", (*BigComplex).Log, complex(-1, negZero), complex(0, -math.Pi)},
+		{"log", (*BigComplex).Log, 0, complex(math.Inf(-1), 0)},
+		{"log", (*BigComplex).Log, complex(negZero, negZero), complex(math.Inf(-1), -math.Pi)},
+	}
+	for _, tt := range tests {
+		have := tt.op(new(BigComplex), ttBig128(tt.x)).Complex128()
+		if have.r != real(tt.want) || have.i != imag(tt.want) ||
+			math.Signbit(have.i) != math.Signbit(imag(tt.want)) {
+			t.Errorf("`%s(%v)` failed;\nwant: %v\nhave: %v", tt.name, tt.x, tt.want, have)
+		}
+	}
+}
+
+func TestBigComplexHighPrecision(t *testing.T) {
+	const prec = 300
+	const (
+		pi = "3.14159265358979323846264338327950288419716939937510582097494459"
+		e  = "2.71828182845904523536028747135266249775724709369995957496696763"
+	)
+	text := func(x *big.Float) string { return x.Text('f', 62) }
+
+	log := new(BigComplex).SetPrec(prec).Log(NewBigComplex(-1, 0).SetPrec(prec))
+	if have := text(log.Imag()); have != pi {
+		t.Errorf("log(-1) imag part:\nwant: %s\nhave: %s", pi, have)
+	}
+	exp := new(BigComplex).SetPrec(prec).Exp(NewBigComplex(1, 0).SetPrec(prec))
+	if have := text(exp.Real()); have != e {
+		t.Errorf("exp(1) real part:\nwant: %s\nhave: %s", e, have)
+	}
+
+	// exp(log(x)) = x and sqrt(x)^2 = x.
+	eps := new(big.Float).SetMantExp(big.NewFloat(1), -prec+8)
+	for _, v := range ttBigValues128 {
+		x := ttBig128(v).SetPrec(prec)
+		roundTrips := map[string]*BigComplex{
+			"exp(log(x))": new(BigComplex).Exp(new(BigComplex).Log(x)),
+			"sqrt(x)^2": new(BigComplex).Mul(
+				new(BigComplex).Sqrt(x), new(BigComplex).Sqrt(x)),
+		}
+		for name, have := range roundTrips {
+			diff := new(BigComplex).Sub(have, x).Abs()
+			if diff.Cmp(new(big.Float).Mul(eps, x.Abs())) > 0 {
+				t.Errorf("%s for x=%v: error %v is too big", name, v, diff)
+			}
+		}
+	}
+}
+
+func TestBigComplexConversions(t *testing.T) {
+	for _, x := range ttSpecialValues64() {
+		c := ttFromBuiltin64(x)
+		if !ttIsFinite64(c) {
+			continue
+		}
+		if have := new(BigComplex).SetComplex64(c); have.Prec() != 24 || !have.Complex64().Eq(c) {
+			t.Errorf("Complex64 round trip for %v: have %v, precision %d", c, have, have.Prec())
+		}
+		c128 := c.Complex128()
+		if have := new(BigComplex).SetComplex128(c128); have.Prec() != 53 || !have.Complex128().Eq(c128) {
+			t.Errorf("Complex128 round trip for %v: have %v, precision %d", c128, have, have.Prec())
+		}
+	}
+
+	// 1 - 2^-60 is rounded with the receiver rounding mode.
+	x := new(BigComplex).SetPrec(100)
+	x.Real().Sub(big.NewFloat(1), new(big.Float).SetMantExp(big.NewFloat(1), -60))
+	if have := x.Complex128().Real(); have != 1 {
+		t.Errorf("ToNearestEven: want 1, have %v", have)
+	}
+	if have := x.Complex64().Real(); have != 1 {
+		t.Errorf("ToNearestEven: want 1, have %v", have)
+	}
+	x.SetMode(big.ToZero)
+	if have, want := x.Complex128().Real(), math.Nextafter(1, 0); have != want {
+		t.Errorf("ToZero: want %v, have %v", want, have)
+	}
+	if have, want := x.Complex64().Real(), math.Nextafter32(1, 0); have != want {
+		t.Errorf("ToZero: want %v, have %v", want, have)
+	}
+
+	// Values outside of float32 range.
+	big1e50 := NewBigComplex(1e50, -1e-50)
+	if have := big1e50.Complex64(); !math.IsInf(float64(have.r), +1) || have.i != 0 || !math.Signbit(float64(have.i)) {
+		t.Errorf("complex64(1e50-1e-50i): have %v", have)
+	}
+}
+
+func TestBigComplexSubnormal(t *testing.T) {
+	// Values are given in units of the smallest subnormal.
+	// Rounding to the mantissa precision first and to the subnormal
+	// precision after that would round 512+2^-21 up to 512.
+	tests := []struct {
+		x    float64
+		want map[big.RoundingMode]float64
+	}{
+		{512 + 0x1p-21, map[big.RoundingMode]float64{
+			big.ToNearestEven: 512, big.ToNearestAway: 512, big.ToZero: 512,
+			big.AwayFromZero: 513, big.ToNegativeInf: 512, big.ToPositiveInf: 513,
+		}},
+		{2.5, map[big.RoundingMode]float64{
+			big.ToNearestEven: 2, big.ToNearestAway: 3, big.ToZero: 2,
+			big.AwayFromZero: 3, big.ToNegativeInf: 2, big.ToPositiveInf: 3,
+		}},
+		{0.75, map[big.RoundingMode]float64{
+			big.ToNearestEven: 1, big.ToNearestAway: 1, big.ToZero: 0,
+			big.AwayFromZero: 1, big.ToNegativeInf: 0, big.ToPositiveInf: 1,
+		}},
+		{0.5, map[big.RoundingMode]float64{
+			big.ToNearestEven: 0, big.ToNearestAway: 1, big.ToZero: 0,
+			big.AwayFromZero: 1, big.ToNegativeInf: 0, big.ToPositiveInf: 1,
+		}},
+		{0.25, map[big.RoundingMode]float64{
+			big.ToNearestEven: 0, big.ToNearestAway: 0, big.ToZero: 0,
+			big.AwayFromZero: 1, big.ToNegativeInf: 0, big.ToPositiveInf: 1,
+		}},
+	}
+	// mirror returns the mode that rounds -x like mode rounds x.
+	mirror := func(mode big.RoundingMode) big.RoundingMode {
+		switch mode {
+		case big.ToNegativeInf:
+			return big.ToPositiveInf
+		case big.ToPositiveInf:
+			return big.ToNegativeInf
+		}
+		return mode
+	}
+	for _, test := range tests {
+		for mode := range test.want {
+			// The imaginary part is negated.
+			wantR, wantI := test.want[mode], -test.want[mirror(mode)]
+
+			// SetMantExp resets the rounding mode.
+			x := new(BigComplex).SetPrec(100)
+			x.Real().SetMantExp(big.NewFloat(test.x), -149)
+			x.Imag().SetMantExp(big.NewFloat(-test.x), -149)
+			x.SetMode(mode)
+			have64 := x.Complex64()
+			want64 := NewComplex64(float32(math.Ldexp(wantR, -149)), float32(math.Ldexp(wantI, -149)))
+			if !ttSameBits32(have64.r, want64.r) || !ttSameBits32(have64.i, want64.i) {
+				t.Errorf("%v Complex64(%v ulps):\nwant: %v\nhave: %v", mode, test.x, want64, have64)
+			}
+
+			x.Real().SetMantExp(big.NewFloat(test.x), -1074)
+			x.Imag().SetMantExp(big.NewFloat(-test.x), -1074)
+			x.SetMode(mode)
+			have128 := x.Complex128()
+			want128 := NewComplex128(math.Ldexp(wantR, -1074), math.Ldexp(wantI, -1074))
+			if math.Float64bits(have128.r) != math.Float64bits(want128.r) ||
+				math.Float64bits(have128.i) != math.Float64bits(want128.i) {
+				t.Errorf("%v Complex128(%v ulps):\nwant: %v\nhave: %v", mode, test.x, want128, have128)
+			}
+		}
+	}
+}
+
+func TestBigComplexFormat(t *testing.T) {
+	values := []complex128{0, 1 - 2i, -0.5 + 1e-7i, 12345.678 + 1e21i, complex(math.Pi, -math.E)}
+	formats := []string{"%v", "%g", "%e", "%.3f", "%+.2e", "%10.3f", "%-9.1g", "%G"}
+	for _, x := range values {
+		for _, format := range formats {
+			want := fmt.Sprintf(format, x)
+			have := fmt.Sprintf(format, ttBig128(x))
+			if want != have {
+				t.Errorf("Sprintf(%q, %v):\nwant: %s\nhave: %s", format, x, want, have)
+			}
+		}
+	}
+	for _, v := range ttValues {
+		x := complex(float64(v.r1), float64(v.i1))
+		if want, have := fmt.Sprintf("%v", x), ttBig128(x).String(); want != have {
+			t.Errorf("String(%v):\nwant: %s\nhave: %s", x, want, have)
+		}
+	}
+	if have, want := fmt.Sprintf("%d", NewBigComplex(1, 2)), "%!d(*xmath.BigComplex=(1+2i))"; have != want {
+		t.Errorf("bad verb:\nwant: %s\nhave: %s", want, have)
+	}
+}
+
+func TestBigComplexPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"NaN part", func() { NewBigComplex(math.NaN(), 0) }},
+		{"NaN Complex64", func() { new(BigComplex).SetComplex64(NewComplex64(0, float32(math.NaN()))) }},
+		{"division by zero", func() { new(BigComplex).Quo(NewBigComplex(1, 0), NewBigComplex(0, 0)) }},
+		{"exp of infinite imag", func() { new(BigComplex).Exp(NewBigComplex(0, math.Inf(+1))) }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if _, ok := recover().(big.ErrNaN); !ok {
+					t.Errorf("%s: expected big.ErrNaN panic", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+
+	defer func() {
+		if err, ok := recover().(big.ErrNaN); !ok || err.Error() == "" {
+			t.Errorf("division by zero: expected big.ErrNaN with a message, have %v", err)
+		}
+	}()
+	new(BigComplex).Quo(NewBigComplex(1, 2), new(BigComplex))
+}
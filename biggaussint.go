@@ -0,0 +1,380 @@
+package xmath
+
+import (
+	"cmp"
+	"math/big"
+	"slices"
+)
+
+// BigGaussInt is a Gaussian integer with big.Int parts.
+//
+// Like big.Int, BigGaussInt operations store the result into
+// the receiver and return it. Operands may alias the receiver.
+// The zero value is 0.
+type BigGaussInt struct {
+	re big.Int
+	im big.Int
+}
+
+// NewBigGaussInt returns a new BigGaussInt set to re + im*i.
+func NewBigGaussInt(re, im int64) *BigGaussInt {
+	var z BigGaussInt
+	z.re.SetInt64(re)
+	z.im.SetInt64(im)
+	return &z
+}
+
+// Real returns z real part. The result shares storage with z.
+func (z *BigGaussInt) Real() *big.Int { return &z.re }
+
+// Imag returns z imaginary part. The result shares storage with z.
+func (z *BigGaussInt) Imag() *big.Int { return &z.im }
+
+// Set sets z to x and returns z.
+func (z *BigGaussInt) Set(x *BigGaussInt) *BigGaussInt {
+	z.re.Set(&x.re)
+	z.im.Set(&x.im)
+	return z
+}
+
+// SetParts sets z to re + im*i and returns z.
+func (z *BigGaussInt) SetParts(re, im *big.Int) *BigGaussInt {
+	z.re.Set(re)
+	z.im.Set(im)
+	return z
+}
+
+// SetGaussInt sets z to x and returns z.
+func (z *BigGaussInt) SetGaussInt(x GaussInt) *BigGaussInt {
+	z.re.SetInt64(x.r)
+	z.im.SetInt64(x.i)
+	return z
+}
+
+// GaussInt returns x converted to GaussInt.
+// It returns ErrOverflow if x parts don't fit into int64.
+func (x *BigGaussInt) GaussInt() (GaussInt, error) {
+	if !x.re.IsInt64() || !x.im.IsInt64() {
+		return GaussInt{}, ErrOverflow
+	}
+	return GaussInt{r: x.re.Int64(), i: x.im.Int64()}, nil
+}
+
+// IsZero reports whether x is 0.
+func (x *BigGaussInt) IsZero() bool {
+	return x.re.Sign() == 0 && x.im.Sign() == 0
+}
+
+// Eq reports whether x equals y.
+func (x *BigGaussInt) Eq(y *BigGaussInt) bool {
+	return x.re.Cmp(&y.re) == 0 && x.im.Cmp(&y.im) == 0
+}
+
+// Conj sets z to the conjugate of x and returns z.
+func (z *BigGaussInt) Conj(x *BigGaussInt) *BigGaussInt {
+	z.re.Set(&x.re)
+	z.im.Neg(&x.im)
+	return z
+}
+
+// Add sets z to the sum x+y and returns z.
+func (z *BigGaussInt) Add(x, y *BigGaussInt) *BigGaussInt {
+	z.re.Add(&x.re, &y.re)
+	z.im.Add(&x.im, &y.im)
+	return z
+}
+
+// Sub sets z to the difference x-y and returns z.
+func (z *BigGaussInt) Sub(x, y *BigGaussInt) *BigGaussInt {
+	z.re.Sub(&x.re, &y.re)
+	z.im.Sub(&x.im, &y.im)
+	return z
+}
+
+// Mul sets z to the product x*y and returns z.
+func (z *BigGaussInt) Mul(x, y *BigGaussInt) *BigGaussInt {
+	var ac, bd, ad, bc big.Int
+	ac.Mul(&x.re, &y.re)
+	bd.Mul(&x.im, &y.im)
+	ad.Mul(&x.re, &y.im)
+	bc.Mul(&x.im, &y.re)
+	z.re.Sub(&ac, &bd)
+	z.im.Add(&ad, &bc)
+	return z
+}
+
+// Norm returns the norm of x: re^2 + im^2.
+func (x *BigGaussInt) Norm() *big.Int {
+	var n, t big.Int
+	n.Mul(&x.re, &x.re)
+	t.Mul(&x.im, &x.im)
+	return n.Add(&n, &t)
+}
+
+// DivMod sets z to the quotient x/y and m to the remainder x%y
+// and returns the pair (z, m), such that
+//
+//	x = z*y + m, m.Norm() <= y.Norm()/2
+//
+// The quotient is x*conj(y)/y.Norm() with every part rounded
+// to the nearest integer (halves are rounded up).
+// It panics if y is zero.
+func (z *BigGaussInt) DivMod(x, y, m *BigGaussInt) (*BigGaussInt, *BigGaussInt) {
+	if y.IsZero() {
+		panic("xmath: division by zero")
+	}
+	var num BigGaussInt
+	num.Mul(x, new(BigGaussInt).Conj(y))
+	n := y.Norm()
+
+	// round(a/n) = floor((2a + n) / (2n)).
+	var n2 big.Int
+	n2.Lsh(n, 1)
+	var q BigGaussInt
+	for _, p := range []struct{ dst, src *big.Int }{{&q.re, &num.re}, {&q.im, &num.im}} {
+		p.dst.Lsh(p.src, 1)
+		p.dst.Add(p.dst, n)
+		p.dst.Div(p.dst, &n2)
+	}
+
+	var r BigGaussInt
+	r.Mul(&q, y)
+	r.Sub(x, &r)
+	z.Set(&q)
+	m.Set(&r)
+	return z, m
+}
+
+// GCD sets z to the greatest common divisor of x and y and returns z.
+// The result is normalized, like Normalize does it.
+// GCD(0, 0) is 0.
+func (z *BigGaussInt) GCD(x, y *BigGaussInt) *BigGaussInt {
+	var a, b, q, r BigGaussInt
+	a.Set(x)
+	b.Set(y)
+	for !b.IsZero() {
+		q.DivMod(&a, &b, &r)
+		a.Set(&b)
+		b.Set(&r)
+	}
+	return z.Normalize(&a)
+}
+
+// Normalize sets z to the associate of x (x multiplied by
+// one of the units 1, i, -1, -i) with positive real part and
+// non-negative imaginary part, and returns z.
+// Normalize(0) is 0.
+func (z *BigGaussInt) Normalize(x *BigGaussInt) *BigGaussInt {
+	_, n := bigNormalize(x)
+	return z.Set(n)
+}
+
+// bigNormalize returns the unit u and the normalized associate n
+// of x, such that x = u*n.
+func bigNormalize(x *BigGaussInt) (u, n *BigGaussInt) {
+	re, im := x.re.Sign(), x.im.Sign()
+	n = new(BigGaussInt)
+	switch {
+	case re == 0 && im == 0:
+		return NewBigGaussInt(1, 0), n
+	case re > 0 && im >= 0:
+		return NewBigGaussInt(1, 0), n.Set(x)
+	case re <= 0 && im > 0:
+		// x = i * (im - re*i).
+		n.re.Set(&x.im)
+		n.im.Neg(&x.re)
+		return NewBigGaussInt(0, 1), n
+	case re < 0 && im <= 0:
+		n.re.Neg(&x.re)
+		n.im.Neg(&x.im)
+		return NewBigGaussInt(-1, 0), n
+	default:
+		// x = -i * (-im + re*i).
+		n.re.Neg(&x.im)
+		n.im.Set(&x.re)
+		return NewBigGaussInt(0, -1), n
+	}
+}
+
+// IsPrime reports whether x is a Gaussian prime.
+//
+// x is prime if its norm is a rational prime, or if x is
+// an associate of a rational prime p with p%4 == 3.
+// Primality of rational numbers is tested with big.Int.ProbablyPrime,
+// which is exact for values less than 2^64.
+func (x *BigGaussInt) IsPrime() bool {
+	var p big.Int
+	switch {
+	case x.re.Sign() == 0:
+		p.Abs(&x.im)
+	case x.im.Sign() == 0:
+		p.Abs(&x.re)
+	default:
+		return x.Norm().ProbablyPrime(20)
+	}
+	return p.Bit(0) == 1 && p.Bit(1) == 1 && p.ProbablyPrime(20)
+}
+
+// Factor returns the factorization of x:
+//
+//	x = unit * primes[0] * primes[1] * ...
+//
+// where unit is one of 1, i, -1, -i, and primes are normalized
+// Gaussian primes sorted by norm; repeated factors are repeated.
+// It panics if x is zero.
+//
+// The norm of x is factored with trial division and
+// Pollard's rho method, so factoring values with several
+// large prime factors in their norm may be slow.
+func (x *BigGaussInt) Factor() (unit *BigGaussInt, primes []*BigGaussInt) {
+	if x.IsZero() {
+		panic("xmath: factorization of zero")
+	}
+	rest := new(BigGaussInt).Set(x)
+	var q, r BigGaussInt
+	// divideOut divides rest by p while it's divisible.
+	divideOut := func(p *BigGaussInt) {
+		for {
+			q.DivMod(rest, p, &r)
+			if !r.IsZero() {
+				return
+			}
+			primes = append(primes, p)
+			rest.Set(&q)
+		}
+	}
+
+	for _, p := range factorInt(x.Norm()) {
+		switch {
+		case p.Cmp(big.NewInt(2)) == 0:
+			divideOut(NewBigGaussInt(1, 1))
+		case p.Bit(1) == 1:
+			// p%4 == 3: p is a Gaussian prime, p^2 divides the norm.
+			divideOut(new(BigGaussInt).SetParts(p, new(big.Int)))
+		default:
+			// p%4 == 1: p = pi * conj(pi).
+			pi := splitPrime(p)
+			divideOut(pi)
+			divideOut(new(BigGaussInt).Normalize(new(BigGaussInt).Conj(pi)))
+		}
+	}
+
+	slices.SortFunc(primes, func(a, b *BigGaussInt) int {
+		if c := a.Norm().Cmp(b.Norm()); c != 0 {
+			return c
+		}
+		return cmp.Or(a.re.Cmp(&b.re), a.im.Cmp(&b.im))
+	})
+	return rest, primes
+}
+
+// String returns x formatted like "(re+imi)".
+func (x *BigGaussInt) String() string {
+	s := "(" + x.re.String()
+	if x.im.Sign() >= 0 {
+		s += "+"
+	}
+	return s + x.im.String() + "i)"
+}
+
+// splitPrime returns the normalized Gaussian prime pi, such that
+// p = pi * conj(pi), for a rational prime p with p%4 == 1.
+func splitPrime(p *big.Int) *BigGaussInt {
+	// x = c^((p-1)/4) satisfies x^2 = -1 (mod p) for any
+	// quadratic non-residue c, then pi = gcd(p, x+i).
+	var c, e, x big.Int
+	for c.SetInt64(2); big.Jacobi(&c, p) != -1; c.Add(&c, big.NewInt(1)) {
+	}
+	e.Rsh(p, 2)
+	x.Exp(&c, &e, p)
+	var pi BigGaussInt
+	return pi.GCD(new(BigGaussInt).SetParts(p, new(big.Int)), new(BigGaussInt).SetParts(&x, big.NewInt(1)))
+}
+
+// factorInt returns distinct prime factors of n > 0 in ascending order.
+func factorInt(n *big.Int) []*big.Int {
+	var factors []*big.Int
+	n = new(big.Int).Set(n)
+	var q, r, dd big.Int
+	for d := int64(2); d < 1000; d++ {
+		dd.SetInt64(d)
+		if q.DivMod(n, &dd, &r); r.Sign() != 0 {
+			continue
+		}
+		// Every factor gets its own value, dd is reused.
+		factors = append(factors, big.NewInt(d))
+		for r.Sign() == 0 {
+			n.Set(&q)
+			q.DivMod(n, &dd, &r)
+		}
+	}
+	if n.Cmp(big.NewInt(1)) != 0 {
+		factors = append(factors, factorLarge(n)...)
+	}
+	slices.SortFunc(factors, (*big.Int).Cmp)
+	return slices.CompactFunc(factors, func(a, b *big.Int) bool { return a.Cmp(b) == 0 })
+}
+
+// factorLarge returns prime factors of n that has no small factors.
+func factorLarge(n *big.Int) []*big.Int {
+	if n.ProbablyPrime(20) {
+		return []*big.Int{new(big.Int).Set(n)}
+	}
+	// Rational primes p%4 == 3 appear squared in norms,
+	// and rho method is hopelessly slow on p^2.
+	if s := new(big.Int).Sqrt(n); new(big.Int).Mul(s, s).Cmp(n) == 0 {
+		return factorLarge(s)
+	}
+	d := pollardRho(n)
+	q := new(big.Int).Quo(n, d)
+	return append(factorLarge(d), factorLarge(q)...)
+}
+
+// pollardRho returns a non-trivial divisor of a composite n,
+// found with Brent's variant of Pollard's rho method.
+func pollardRho(n *big.Int) *big.Int {
+	one := big.NewInt(1)
+	var x, y, ys, q, t, d big.Int
+	for c := int64(1); ; c++ {
+		bc := big.NewInt(c)
+		f := func(v *big.Int) {
+			v.Mul(v, v)
+			v.Add(v, bc)
+			v.Mod(v, n)
+		}
+		y.SetInt64(2)
+		q.SetInt64(1)
+		d.SetInt64(1)
+		const m = 128
+		for r := 1; d.Cmp(one) == 0; r *= 2 {
+			x.Set(&y)
+			for i := 0; i < r; i++ {
+				f(&y)
+			}
+			for k := 0; k < r && d.Cmp(one) == 0; k += m {
+				ys.Set(&y)
+				for i := 0; i < min(m, r-k); i++ {
+					f(&y)
+					t.Sub(&x, &y)
+					q.Mul(&q, t.Abs(&t))
+					q.Mod(&q, n)
+				}
+				d.GCD(nil, nil, &q, n)
+			}
+		}
+		if d.Cmp(n) == 0 {
+			// Backtrack from the last saved position.
+			for {
+				f(&ys)
+				t.Sub(&x, &ys)
+				d.GCD(nil, nil, t.Abs(&t), n)
+				if d.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if d.Cmp(n) != 0 {
+			return new(big.Int).Set(&d)
+		}
+	}
+}
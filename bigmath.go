@@ -0,0 +1,258 @@
+package xmath
+
+import (
+	"math/big"
+)
+
+// This file implements elementary functions for big.Float values
+// that are used by BigComplex.
+// All functions return results with the requested precision
+// prec; callers add guard bits to prec.
+
+// bigExp returns e^x.
+func bigExp(x *big.Float, prec uint) *big.Float {
+	res := newBigFloat(prec)
+	switch {
+	case x.Sign() == 0:
+		return res.SetInt64(1)
+	case x.IsInf():
+		if x.Signbit() {
+			return res
+		}
+		return res.SetInf(false)
+	}
+
+	// e^x = (e^(x/2^k))^(2^k), where |x/2^k| < 1/2.
+	k := max(x.MantExp(nil)+1, 0)
+	if k > 40 {
+		// |x| > 2^39, the result exponent overflows.
+		if x.Signbit() {
+			return res
+		}
+		return res.SetInf(false)
+	}
+	wp := prec + uint(k)
+	r := newBigFloat(wp).SetMantExp(x, -k)
+
+	// Taylor series: sum r^n / n!.
+	sum := newBigFloat(wp).SetInt64(1)
+	term := newBigFloat(wp).SetInt64(1)
+	n := newBigFloat(wp)
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, n.SetInt64(i))
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	for i := 0; i < k; i++ {
+		sum.Mul(sum, sum)
+	}
+	return res.Set(sum)
+}
+
+// bigLog returns the natural logarithm of x >= 0.
+func bigLog(x *big.Float, prec uint) *big.Float {
+	res := newBigFloat(prec)
+	switch {
+	case x.Sign() < 0:
+		panic(big.ErrNaN{})
+	case x.Sign() == 0:
+		return res.SetInf(true)
+	case x.IsInf():
+		return res.SetInf(false)
+	}
+
+	// x = m * 2^e, where m is in [1/sqrt(2), sqrt(2)),
+	// so ln(x) = ln(m) + e*ln(2) has no cancellation.
+	m := newBigFloat(prec)
+	e := x.MantExp(m)
+	if m.Cmp(big.NewFloat(0.7071067811865476)) < 0 {
+		m.SetMantExp(m, 1)
+		e--
+	}
+
+	// ln(m) = 2 * atanh((m-1) / (m+1)).
+	one := newBigFloat(prec).SetInt64(1)
+	s := newBigFloat(prec).Sub(m, one)
+	s.Quo(s, newBigFloat(prec).Add(m, one))
+	res.Set(bigAtanhSeries(s, prec))
+	if e != 0 {
+		ln2 := bigAtanhSeries(newBigFloat(prec).Quo(one, big.NewFloat(3)), prec)
+		res.Add(res, ln2.Mul(ln2, newBigFloat(prec).SetInt64(int64(e))))
+	}
+	return res.SetMantExp(res, 1)
+}
+
+// bigAtanhSeries returns atanh(s) for small |s|.
+func bigAtanhSeries(s *big.Float, prec uint) *big.Float {
+	sum := newBigFloat(prec).Set(s)
+	if s.Sign() == 0 {
+		return sum
+	}
+	s2 := newBigFloat(prec).Mul(s, s)
+	pow := newBigFloat(prec).Set(s)
+	term := newBigFloat(prec)
+	n := newBigFloat(prec)
+	for i := int64(3); ; i += 2 {
+		pow.Mul(pow, s2)
+		term.Quo(pow, n.SetInt64(i))
+		if term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(prec) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	return sum
+}
+
+// bigPi returns Pi.
+func bigPi(prec uint) *big.Float {
+	pi := bigAtan(newBigFloat(prec).SetInt64(1), prec)
+	return pi.SetMantExp(pi, 2)
+}
+
+// bigAtan returns the arctangent of x.
+func bigAtan(x *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		return newBigFloat(prec).Set(x)
+	}
+	if x.IsInf() {
+		pi := bigPi(prec)
+		pi.SetMantExp(pi, -1)
+		if x.Signbit() {
+			pi.Neg(pi)
+		}
+		return pi
+	}
+	one := newBigFloat(prec).SetInt64(1)
+	if x.MantExp(nil) > 0 && newBigFloat(prec).Abs(x).Cmp(one) > 0 {
+		// atan(x) = sign(x)*Pi/2 - atan(1/x).
+		res := bigPi(prec)
+		res.SetMantExp(res, -1)
+		if x.Signbit() {
+			res.Neg(res)
+		}
+		return res.Sub(res, bigAtan(newBigFloat(prec).Quo(one, x), prec))
+	}
+
+	// Argument halving: atan(x) = 2*atan(x / (1 + sqrt(1 + x^2))).
+	const halvings = 8
+	r := newBigFloat(prec).Set(x)
+	t := newBigFloat(prec)
+	for i := 0; i < halvings; i++ {
+		t.Mul(r, r)
+		t.Add(t, one)
+		t.Sqrt(t)
+		t.Add(t, one)
+		r.Quo(r, t)
+	}
+
+	// Taylor series: sum (-1)^n * r^(2n+1) / (2n+1).
+	sum := newBigFloat(prec).Set(r)
+	r2 := newBigFloat(prec).Mul(r, r)
+	r2.Neg(r2)
+	pow := newBigFloat(prec).Set(r)
+	term := newBigFloat(prec)
+	n := newBigFloat(prec)
+	for i := int64(3); ; i += 2 {
+		pow.Mul(pow, r2)
+		term.Quo(pow, n.SetInt64(i))
+		if term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(prec) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	return sum.SetMantExp(sum, halvings)
+}
+
+// bigAtan2 returns the arctangent of y/x, using the signs of
+// the two to determine the quadrant, like math.Atan2 does.
+func bigAtan2(y, x *big.Float, prec uint) *big.Float {
+	pi := bigPi(prec)
+	res := newBigFloat(prec)
+	switch {
+	case y.Sign() == 0:
+		if x.Signbit() {
+			res.Set(pi)
+		}
+	case x.Sign() == 0:
+		res.SetMantExp(pi, -1)
+	case x.IsInf() && y.IsInf():
+		if x.Signbit() {
+			// 3*Pi/4.
+			res.SetMantExp(pi, -2)
+			res.Mul(res, big.NewFloat(3))
+		} else {
+			res.SetMantExp(pi, -2)
+		}
+	case x.IsInf():
+		if x.Signbit() {
+			res.Set(pi)
+		}
+	case y.IsInf():
+		res.SetMantExp(pi, -1)
+	default:
+		res.Quo(y, x)
+		res = bigAtan(res.Abs(res), prec)
+		if x.Signbit() {
+			res.Sub(pi, res)
+		}
+	}
+	// res is computed for |y|, apply the y sign.
+	if y.Signbit() {
+		res.Neg(res)
+	}
+	return res
+}
+
+// bigSinCos returns sin(x) and cos(x) for finite x.
+func bigSinCos(x *big.Float, prec uint) (sin, cos *big.Float) {
+	if x.IsInf() {
+		panic(big.ErrNaN{})
+	}
+
+	// Reduce x to [-Pi, Pi]; the reduction needs extra bits
+	// for large x.
+	wp := prec + uint(max(x.MantExp(nil), 0))
+	r := newBigFloat(wp).Set(x)
+	twoPi := bigPi(wp)
+	twoPi.SetMantExp(twoPi, 1)
+	if r.MantExp(nil) > 1 {
+		q := newBigFloat(wp).Quo(r, twoPi)
+		n, _ := q.Int(nil)
+		// Round to the nearest integer.
+		frac := newBigFloat(wp).Sub(q, newBigFloat(wp).SetInt(n))
+		if frac.Cmp(big.NewFloat(0.5)) > 0 {
+			n.Add(n, big.NewInt(1))
+		} else if frac.Cmp(big.NewFloat(-0.5)) < 0 {
+			n.Sub(n, big.NewInt(1))
+		}
+		r.Sub(r, newBigFloat(wp).Mul(twoPi, newBigFloat(wp).SetInt(n)))
+	}
+
+	// Taylor series for both functions.
+	sin = newBigFloat(prec).Set(r)
+	cos = newBigFloat(prec).SetInt64(1)
+	term := newBigFloat(prec).Set(r)
+	n := newBigFloat(prec)
+	for i := int64(2); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, n.SetInt64(i))
+		if term.Sign() == 0 || term.MantExp(nil) < -int(prec) {
+			break
+		}
+		// The term is r^i / i!, its sign depends on i.
+		switch i % 4 {
+		case 0:
+			cos.Add(cos, term)
+		case 1:
+			sin.Add(sin, term)
+		case 2:
+			cos.Sub(cos, term)
+		case 3:
+			sin.Sub(sin, term)
+		}
+	}
+	return sin, cos
+}
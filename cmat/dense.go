@@ -0,0 +1,132 @@
+// Package cmat implements dense matrices of Complex64 and Complex128 values.
+//
+// Matrices are stored in row-major order.
+// Functions with "To" suffix store the result into dst and return it;
+// dst must not share storage with the operands.
+//
+// Functions panic if the matrix dimensions are not compatible.
+package cmat
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/vec"
+)
+
+const (
+	errShape  = "cmat: dimension mismatch"
+	errSquare = "cmat: matrix is not square"
+	errIndex  = "cmat: index out of range"
+	errDims   = "cmat: negative dimension"
+)
+
+// Dense64 is a dense row-major matrix of Complex64 values.
+type Dense64 struct {
+	rows, cols int
+	data       []xmath.Complex64
+}
+
+// NewDense64 returns a rows x cols matrix that uses data as its
+// backing storage; element (i, j) is data[i*cols+j].
+// If data is nil, a new zeroed slice is allocated.
+// It panics if len(data) is not equal to rows*cols.
+func NewDense64(rows, cols int, data []xmath.Complex64) *Dense64 {
+	if rows < 0 || cols < 0 {
+		panic(errDims)
+	}
+	if data == nil {
+		data = make([]xmath.Complex64, rows*cols)
+	}
+	if len(data) != rows*cols {
+		panic(errShape)
+	}
+	return &Dense64{rows: rows, cols: cols, data: data}
+}
+
+// Identity64 returns n x n identity matrix.
+func Identity64(n int) *Dense64 {
+	m := NewDense64(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.data[i*n+i] = xmath.NewComplex64(1, 0)
+	}
+	return m
+}
+
+// Dims returns the number of rows and columns.
+func (m *Dense64) Dims() (rows, cols int) { return m.rows, m.cols }
+
+// At returns the (i, j) element.
+func (m *Dense64) At(i, j int) xmath.Complex64 {
+	m.checkIndex(i, j)
+	return m.data[i*m.cols+j]
+}
+
+// Set assigns v to the (i, j) element.
+func (m *Dense64) Set(i, j int, v xmath.Complex64) {
+	m.checkIndex(i, j)
+	m.data[i*m.cols+j] = v
+}
+
+// Row returns i-th row. The result shares storage with m.
+func (m *Dense64) Row(i int) []xmath.Complex64 {
+	if uint(i) >= uint(m.rows) {
+		panic(errIndex)
+	}
+	return m.data[i*m.cols : (i+1)*m.cols : (i+1)*m.cols]
+}
+
+// RawData returns the backing storage of m.
+func (m *Dense64) RawData() []xmath.Complex64 { return m.data }
+
+// Clone returns a deep copy of m.
+func (m *Dense64) Clone() *Dense64 {
+	data := make([]xmath.Complex64, len(m.data))
+	copy(data, m.data)
+	return &Dense64{rows: m.rows, cols: m.cols, data: data}
+}
+
+func (m *Dense64) checkIndex(i, j int) {
+	if uint(i) >= uint(m.rows) || uint(j) >= uint(m.cols) {
+		panic(errIndex)
+	}
+}
+
+// Mul returns the matrix product a*b.
+func Mul(a, b *Dense64) *Dense64 {
+	return MulTo(NewDense64(a.rows, b.cols, nil), a, b)
+}
+
+// MulTo computes the matrix product dst = a*b.
+//
+// Every element is accumulated as dst[i][j] += a[i][k]*b[k][j]
+// for ascending k, with the product rounded to Complex64 first.
+func MulTo(dst, a, b *Dense64) *Dense64 {
+	if a.cols != b.rows || dst.rows != a.rows || dst.cols != b.cols {
+		panic(errShape)
+	}
+	clear(dst.data)
+	for i := 0; i < a.rows; i++ {
+		row := dst.Row(i)
+		for k, x := range a.Row(i) {
+			vec.Axpy(x, b.Row(k), row)
+		}
+	}
+	return dst
+}
+
+// ConjTranspose returns the conjugate transpose of a.
+func ConjTranspose(a *Dense64) *Dense64 {
+	return ConjTransposeTo(NewDense64(a.cols, a.rows, nil), a)
+}
+
+// ConjTransposeTo stores the conjugate transpose of a into dst.
+func ConjTransposeTo(dst, a *Dense64) *Dense64 {
+	if dst.rows != a.cols || dst.cols != a.rows {
+		panic(errShape)
+	}
+	for i := 0; i < a.rows; i++ {
+		for j, x := range a.Row(i) {
+			dst.data[j*dst.cols+i] = x.Conj()
+		}
+	}
+	return dst
+}
@@ -0,0 +1,127 @@
+package cmat
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// Dense128 is a dense row-major matrix of Complex128 values.
+type Dense128 struct {
+	rows, cols int
+	data       []xmath.Complex128
+}
+
+// NewDense128 returns a rows x cols matrix that uses data as its
+// backing storage; element (i, j) is data[i*cols+j].
+// If data is nil, a new zeroed slice is allocated.
+// It panics if len(data) is not equal to rows*cols.
+func NewDense128(rows, cols int, data []xmath.Complex128) *Dense128 {
+	if rows < 0 || cols < 0 {
+		panic(errDims)
+	}
+	if data == nil {
+		data = make([]xmath.Complex128, rows*cols)
+	}
+	if len(data) != rows*cols {
+		panic(errShape)
+	}
+	return &Dense128{rows: rows, cols: cols, data: data}
+}
+
+// Identity128 returns n x n identity matrix.
+func Identity128(n int) *Dense128 {
+	m := NewDense128(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.data[i*n+i] = xmath.NewComplex128(1, 0)
+	}
+	return m
+}
+
+// Dims returns the number of rows and columns.
+func (m *Dense128) Dims() (rows, cols int) { return m.rows, m.cols }
+
+// At returns the (i, j) element.
+func (m *Dense128) At(i, j int) xmath.Complex128 {
+	m.checkIndex(i, j)
+	return m.data[i*m.cols+j]
+}
+
+// Set assigns v to the (i, j) element.
+func (m *Dense128) Set(i, j int, v xmath.Complex128) {
+	m.checkIndex(i, j)
+	m.data[i*m.cols+j] = v
+}
+
+// Row returns i-th row. The result shares storage with m.
+func (m *Dense128) Row(i int) []xmath.Complex128 {
+	if uint(i) >= uint(m.rows) {
+		panic(errIndex)
+	}
+	return m.data[i*m.cols : (i+1)*m.cols : (i+1)*m.cols]
+}
+
+// RawData returns the backing storage of m.
+func (m *Dense128) RawData() []xmath.Complex128 { return m.data }
+
+// Clone returns a deep copy of m.
+func (m *Dense128) Clone() *Dense128 {
+	data := make([]xmath.Complex128, len(m.data))
+	copy(data, m.data)
+	return &Dense128{rows: m.rows, cols: m.cols, data: data}
+}
+
+func (m *Dense128) checkIndex(i, j int) {
+	if uint(i) >= uint(m.rows) || uint(j) >= uint(m.cols) {
+		panic(errIndex)
+	}
+}
+
+// Dense128 returns m converted to Dense128. The conversion is exact.
+func (m *Dense64) Dense128() *Dense128 {
+	res := NewDense128(m.rows, m.cols, nil)
+	for i, x := range m.data {
+		res.data[i] = x.Complex128()
+	}
+	return res
+}
+
+// Dense64 returns m with every element rounded to Complex64.
+func (m *Dense128) Dense64() *Dense64 {
+	res := NewDense64(m.rows, m.cols, nil)
+	for i, x := range m.data {
+		res.data[i] = x.Complex64()
+	}
+	return res
+}
+
+// Mul128 returns the matrix product a*b.
+func Mul128(a, b *Dense128) *Dense128 {
+	return Mul128To(NewDense128(a.rows, b.cols, nil), a, b)
+}
+
+// Mul128To computes the matrix product dst = a*b.
+func Mul128To(dst, a, b *Dense128) *Dense128 {
+	if a.cols != b.rows || dst.rows != a.rows || dst.cols != b.cols {
+		panic(errShape)
+	}
+	clear(dst.data)
+	for i := 0; i < a.rows; i++ {
+		row := dst.Row(i)
+		for k, x := range a.Row(i) {
+			for j, y := range b.Row(k) {
+				row[j].MulAddAssign(x, y)
+			}
+		}
+	}
+	return dst
+}
+
+// ConjTranspose128 returns the conjugate transpose of a.
+func ConjTranspose128(a *Dense128) *Dense128 {
+	dst := NewDense128(a.cols, a.rows, nil)
+	for i := 0; i < a.rows; i++ {
+		for j, x := range a.Row(i) {
+			dst.data[j*dst.cols+i] = x.Conj()
+		}
+	}
+	return dst
+}
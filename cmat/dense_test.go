@@ -0,0 +1,217 @@
+package cmat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+// Helper functions.
+
+func ttInput(seed uint64, rows, cols int) *Dense64 {
+	r := xrand.New(seed)
+	m := NewDense64(rows, cols, nil)
+	xrand.Fill(m.data, func() xmath.Complex64 { return r.Disk(1) })
+	return m
+}
+
+func ttToBuiltin(xs []xmath.Complex64) []complex64 {
+	res := make([]complex64, len(xs))
+	for i, x := range xs {
+		res[i] = complex(x.Real(), x.Imag())
+	}
+	return res
+}
+
+func ttTo128(xs []xmath.Complex64) []complex128 {
+	res := make([]complex128, len(xs))
+	for i, x := range xs {
+		res[i] = complex128(complex(x.Real(), x.Imag()))
+	}
+	return res
+}
+
+// ttSame reports whether have and want are bit-identical.
+func ttSame(have []xmath.Complex64, want []complex64) bool {
+	if len(have) != len(want) {
+		return false
+	}
+	for i, x := range have {
+		if math.Float32bits(x.Real()) != math.Float32bits(real(want[i])) ||
+			math.Float32bits(x.Imag()) != math.Float32bits(imag(want[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// ttMul128 computes a*b product of n x m and m x p matrices
+// with complex128 precision.
+func ttMul128(a, b []complex128, n, m, p int) []complex128 {
+	res := make([]complex128, n*p)
+	for i := 0; i < n; i++ {
+		for k := 0; k < m; k++ {
+			for j := 0; j < p; j++ {
+				res[i*p+j] += a[i*m+k] * b[k*p+j]
+			}
+		}
+	}
+	return res
+}
+
+// ttNorm returns the Frobenius norm of x.
+func ttNorm(x []complex128) float64 {
+	var sum float64
+	for _, v := range x {
+		sum += real(v)*real(v) + imag(v)*imag(v)
+	}
+	return math.Sqrt(sum)
+}
+
+// Builtin complex64 implementation of the same algorithms.
+// It performs exactly the same operations in the same order.
+
+func ttMulBuiltin(a, b []complex64, n, m, p int) []complex64 {
+	res := make([]complex64, n*p)
+	for i := 0; i < n; i++ {
+		for k := 0; k < m; k++ {
+			x := a[i*m+k]
+			for j := 0; j < p; j++ {
+				res[i*p+j] += x * b[k*p+j]
+			}
+		}
+	}
+	return res
+}
+
+var ttShapes = [][3]int{
+	{0, 0, 0}, {1, 1, 1}, {1, 5, 1}, {3, 1, 4}, {4, 4, 4}, {7, 3, 5}, {16, 16, 16}, {33, 17, 9},
+}
+
+func TestMul(t *testing.T) {
+	for _, s := range ttShapes {
+		a := ttInput(1, s[0], s[1])
+		b := ttInput(2, s[1], s[2])
+		have := Mul(a, b)
+		if r, c := have.Dims(); r != s[0] || c != s[2] {
+			t.Fatalf("%v: want %dx%d result, have %dx%d", s, s[0], s[2], r, c)
+		}
+
+		want := ttMulBuiltin(ttToBuiltin(a.data), ttToBuiltin(b.data), s[0], s[1], s[2])
+		if !ttSame(have.data, want) {
+			t.Errorf("%v: results differ from builtin", s)
+		}
+
+		want128 := ttMul128(ttTo128(a.data), ttTo128(b.data), s[0], s[1], s[2])
+		diff := ttTo128(have.data)
+		for i := range diff {
+			diff[i] -= want128[i]
+		}
+		if err := ttNorm(diff); err > 1e-6*math.Max(ttNorm(want128), 1) {
+			t.Errorf("%v: error %g is too big", s, err)
+		}
+	}
+}
+
+func TestConjTranspose(t *testing.T) {
+	a := ttInput(1, 3, 5)
+	at := ConjTranspose(a)
+	if r, c := at.Dims(); r != 5 || c != 3 {
+		t.Fatalf("want 5x3 result, have %dx%d", r, c)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 5; j++ {
+			if want, have := a.At(i, j).Conj(), at.At(j, i); !have.Eq(want) {
+				t.Errorf("(%d, %d):\nwant: %v\nhave: %v", j, i, want, have)
+			}
+		}
+	}
+}
+
+func TestDense64(t *testing.T) {
+	m := NewDense64(2, 3, nil)
+	m.Set(1, 2, xmath.NewComplex64(1, 2))
+	if have := m.RawData()[5]; !have.Eq(xmath.NewComplex64(1, 2)) {
+		t.Errorf("Set: data[5] is %v", have)
+	}
+	if have := m.Row(1)[2]; !have.Eq(m.At(1, 2)) {
+		t.Errorf("Row: have %v", have)
+	}
+
+	c := m.Clone()
+	c.Set(0, 0, xmath.NewComplex64(3, 0))
+	if !m.At(0, 0).IsZero() {
+		t.Errorf("Clone shares storage")
+	}
+
+	id := Identity64(3)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := xmath.NewComplex64(0, 0)
+			if i == j {
+				want = xmath.NewComplex64(1, 0)
+			}
+			if have := id.At(i, j); !have.Eq(want) {
+				t.Errorf("Identity64(%d, %d): have %v", i, j, have)
+			}
+		}
+	}
+}
+
+func TestPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"bad data length", func() { NewDense64(2, 2, make([]xmath.Complex64, 3)) }},
+		{"negative dims", func() { NewDense64(-1, 2, nil) }},
+		{"At out of range", func() { NewDense64(2, 2, nil).At(0, 2) }},
+		{"Set out of range", func() { NewDense64(2, 2, nil).Set(-1, 0, xmath.Complex64{}) }},
+		{"Row out of range", func() { NewDense64(2, 2, nil).Row(2) }},
+		{"Mul shape", func() { Mul(NewDense64(2, 3, nil), NewDense64(2, 3, nil)) }},
+		{"MulTo dst shape", func() { MulTo(NewDense64(2, 2, nil), NewDense64(2, 3, nil), NewDense64(3, 3, nil)) }},
+		{"ConjTransposeTo shape", func() { ConjTransposeTo(NewDense64(2, 3, nil), NewDense64(2, 3, nil)) }},
+		{"LU not square", func() { LU(NewDense64(2, 3, nil)) }},
+		{"Solve shape", func() { Solve(Identity64(2), NewDense64(3, 1, nil)) }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+var ttBenchSizes = []int{16, 64, 128, 512}
+
+func BenchmarkMulBuiltin(b *testing.B) {
+	for _, n := range ttBenchSizes {
+		x := ttToBuiltin(ttInput(1, n, n).data)
+		y := ttToBuiltin(ttInput(2, n, n).data)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ttMulBuiltin(x, y, n, n, n)
+			}
+		})
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	for _, n := range ttBenchSizes {
+		x := ttInput(1, n, n)
+		y := ttInput(2, n, n)
+		dst := NewDense64(n, n, nil)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				MulTo(dst, x, y)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package cmat
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// ErrNoConvergence is returned when an iterative algorithm
+// fails to converge.
+var ErrNoConvergence = errors.New("cmat: no convergence")
+
+// maxQLIterations is the maximum number of QL iterations per eigenvalue.
+const maxQLIterations = 30
+
+// EigenHermitian computes the eigendecomposition of a Hermitian matrix:
+//
+//	a = V * diag(values) * V^H
+//
+// where V is unitary. Eigenvalues are real and sorted in ascending
+// order, values[j] corresponds to the column j of V.
+//
+// Only the lower triangle of a is used, the upper triangle is assumed
+// to be its conjugate transpose. a is not modified.
+// It panics if a is not square.
+//
+// The decomposition is computed with Complex128 precision,
+// the results are rounded to float32 and Complex64.
+func EigenHermitian(a *Dense64) (values []float32, vectors *Dense64, err error) {
+	values128, vectors128, err := EigenHermitian128(a.Dense128())
+	if err != nil {
+		return nil, nil, err
+	}
+	values = make([]float32, len(values128))
+	for i, v := range values128 {
+		values[i] = float32(v)
+	}
+	return values, vectors128.Dense64(), nil
+}
+
+// EigenHermitian128 is like EigenHermitian, but operates on Dense128.
+//
+// The matrix is reduced to the real symmetric tridiagonal form with
+// Householder reflections, then the tridiagonal matrix is diagonalized
+// with the implicit QL algorithm.
+func EigenHermitian128(a *Dense128) (values []float64, vectors *Dense128, err error) {
+	if a.rows != a.cols {
+		panic(errSquare)
+	}
+	n := a.rows
+	d, e, z := tridiagonalize(a)
+	if err := tql2(d, e, z); err != nil {
+		return nil, nil, err
+	}
+
+	// Sort eigenvalues and eigenvectors in ascending order.
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		switch {
+		case d[i] < d[j]:
+			return -1
+		case d[i] > d[j]:
+			return +1
+		}
+		return 0
+	})
+	values = make([]float64, n)
+	vectors = NewDense128(n, n, nil)
+	for j, k := range order {
+		values[j] = d[k]
+		for i := 0; i < n; i++ {
+			vectors.data[i*n+j] = z.data[i*n+k]
+		}
+	}
+	return values, vectors, nil
+}
+
+// tridiagonalize reduces a Hermitian matrix a to the real symmetric
+// tridiagonal form:
+//
+//	a = Z * T * Z^H
+//
+// T has d on the diagonal and e[i] = T[i+1][i] = T[i][i+1]
+// below and above it; e[n-1] is 0. Z is unitary.
+func tridiagonalize(a *Dense128) (d, e []float64, z *Dense128) {
+	n := a.rows
+	w := NewDense128(n, n, nil)
+	// Restore the upper triangle from the lower one.
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			x := a.data[i*n+j]
+			w.data[i*n+j] = x
+			w.data[j*n+i] = x.Conj()
+		}
+		w.data[i*n+i] = xmath.NewComplex128(a.data[i*n+i].Real(), 0)
+	}
+	z = Identity128(n)
+
+	v := make([]xmath.Complex128, n)
+	p := make([]xmath.Complex128, n)
+	for k := 0; k < n-2; k++ {
+		// Householder reflection H = I - tau*v*v^H maps
+		// x = w[k+1:n][k] to alpha*e1.
+		m := k + 1
+		var norm float64
+		for i := m; i < n; i++ {
+			norm = math.Hypot(norm, w.data[i*n+k].Abs())
+		}
+		if norm == 0 {
+			continue
+		}
+		x0 := w.data[m*n+k]
+		phase := xmath.NewComplex128(1, 0)
+		if abs0 := x0.Abs(); abs0 != 0 {
+			phase = xmath.NewComplex128(x0.Real()/abs0, x0.Imag()/abs0)
+		}
+		// alpha = -phase*norm avoids cancellation in v[0] = x0 - alpha.
+		alpha := xmath.NewComplex128(-phase.Real()*norm, -phase.Imag()*norm)
+		clear(v)
+		var vNorm2 float64
+		for i := m; i < n; i++ {
+			v[i] = w.data[i*n+k]
+			if i == m {
+				v[i].SubAssign(alpha)
+			}
+			vNorm2 += abs2(v[i])
+		}
+		tau := 2 / vNorm2
+
+		// w = H*w*H = w - v*q^H - q*v^H,
+		// where p = tau*w*v and q = p - (tau/2)*(v^H*p)*v.
+		var vp xmath.Complex128
+		for i := m; i < n; i++ {
+			var sum xmath.Complex128
+			for j := m; j < n; j++ {
+				sum.MulAddAssign(w.data[i*n+j], v[j])
+			}
+			p[i] = scale(sum, tau)
+			vp.MulAddAssign(v[i].Conj(), p[i])
+		}
+		half := scale(vp, tau/2)
+		for i := m; i < n; i++ {
+			p[i].SubAssign(half.Mul(v[i]))
+		}
+		for i := m; i < n; i++ {
+			for j := m; j < n; j++ {
+				x := &w.data[i*n+j]
+				x.SubAssign(v[i].Mul(p[j].Conj()))
+				x.SubAssign(p[i].Mul(v[j].Conj()))
+			}
+		}
+		w.data[m*n+k] = alpha
+		w.data[k*n+m] = alpha.Conj()
+		for i := m + 1; i < n; i++ {
+			w.data[i*n+k] = xmath.Complex128{}
+			w.data[k*n+i] = xmath.Complex128{}
+		}
+
+		// z = z*H.
+		for r := 0; r < n; r++ {
+			row := z.Row(r)
+			var sum xmath.Complex128
+			for j := m; j < n; j++ {
+				sum.MulAddAssign(row[j], v[j])
+			}
+			sum = scale(sum, tau)
+			for j := m; j < n; j++ {
+				row[j].SubAssign(sum.Mul(v[j].Conj()))
+			}
+		}
+	}
+
+	// T = D*S*D^H, where D is a diagonal unitary matrix and S
+	// is real: d[i+1] = d[i]*e[i]/|e[i]| makes S[i+1][i] = |e[i]|.
+	// The columns of z are scaled by D.
+	d = make([]float64, n)
+	e = make([]float64, n)
+	phase := xmath.NewComplex128(1, 0)
+	for i := 0; i < n; i++ {
+		d[i] = w.data[i*n+i].Real()
+		if i > 0 {
+			sub := w.data[i*n+i-1]
+			if abs := sub.Abs(); abs != 0 {
+				e[i-1] = abs
+				phase = phase.Mul(xmath.NewComplex128(sub.Real()/abs, sub.Imag()/abs))
+			}
+			for r := 0; r < n; r++ {
+				z.data[r*n+i].MulAssign(phase)
+			}
+		}
+	}
+	return d, e, z
+}
+
+// tql2 computes eigenvalues and eigenvectors of the real symmetric
+// tridiagonal matrix with the implicit QL algorithm.
+// The code is derived from the EISPACK tql2 procedure.
+//
+// On entry, d and e hold the diagonal and subdiagonal, like
+// tridiagonalize returns them. On exit, d holds eigenvalues
+// (not sorted), e is destroyed, and the eigenvectors are
+// accumulated into z columns.
+func tql2(d, e []float64, z *Dense128) error {
+	n := len(d)
+	eps := math.Nextafter(1, 2) - 1
+	var f, tst1 float64
+	for l := 0; l < n; l++ {
+		// Find a small subdiagonal element.
+		tst1 = math.Max(tst1, math.Abs(d[l])+math.Abs(e[l]))
+		m := l
+		for m < n-1 && math.Abs(e[m]) > eps*tst1 {
+			m++
+		}
+
+		// If m == l, d[l] is already an eigenvalue,
+		// otherwise, iterate.
+		for iter := 0; m > l; iter++ {
+			if iter == maxQLIterations {
+				return ErrNoConvergence
+			}
+
+			// Compute implicit shift.
+			g := d[l]
+			p := (d[l+1] - g) / (2 * e[l])
+			r := math.Hypot(p, 1)
+			if p < 0 {
+				r = -r
+			}
+			d[l] = e[l] / (p + r)
+			d[l+1] = e[l] * (p + r)
+			dl1 := d[l+1]
+			h := g - d[l]
+			for i := l + 2; i < n; i++ {
+				d[i] -= h
+			}
+			f += h
+
+			// Implicit QL transformation.
+			p = d[m]
+			c, c2, c3 := 1.0, 1.0, 1.0
+			el1 := e[l+1]
+			var s, s2 float64
+			for i := m - 1; i >= l; i-- {
+				c3 = c2
+				c2 = c
+				s2 = s
+				g = c * e[i]
+				h = c * p
+				r = math.Hypot(p, e[i])
+				e[i+1] = s * r
+				s = e[i] / r
+				c = p / r
+				p = c*d[i] - s*g
+				d[i+1] = h + s*(c*g+s*d[i])
+
+				// Accumulate the transformation.
+				for k := 0; k < n; k++ {
+					row := z.Row(k)
+					t := row[i+1]
+					row[i+1] = scale(row[i], s).Add(scale(t, c))
+					row[i] = scale(row[i], c).Sub(scale(t, s))
+				}
+			}
+			p = -s * s2 * c3 * el1 * e[l] / dl1
+			e[l] = s * p
+			d[l] = c * p
+
+			if math.Abs(e[l]) <= eps*tst1 {
+				break
+			}
+		}
+		d[l] += f
+		e[l] = 0
+	}
+	return nil
+}
+
+// scale returns x*s for real s.
+func scale(x xmath.Complex128, s float64) xmath.Complex128 {
+	return xmath.NewComplex128(x.Real()*s, x.Imag()*s)
+}
+
+// abs2 returns |x|^2.
+func abs2(x xmath.Complex128) float64 {
+	return x.Real()*x.Real() + x.Imag()*x.Imag()
+}
@@ -0,0 +1,190 @@
+package cmat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// ttHermitian returns a random n x n Hermitian matrix.
+func ttHermitian(seed uint64, n int) *Dense128 {
+	b := ttInput(seed, n, n).Dense128()
+	a := NewDense128(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			x := b.data[i*n+j].Add(b.data[j*n+i].Conj())
+			a.data[i*n+j] = xmath.NewComplex128(x.Real()/2, x.Imag()/2)
+		}
+	}
+	return a
+}
+
+func ttNorm128(m *Dense128) float64 {
+	var sum float64
+	for _, x := range m.data {
+		sum += abs2(x)
+	}
+	return math.Sqrt(sum)
+}
+
+// ttEigenErrors returns the relative reconstruction residual
+// ||a*V - V*diag(values)|| / ||a|| and the orthogonality
+// error ||V^H*V - I||.
+func ttEigenErrors(a *Dense128, values []float64, vectors *Dense128) (resid, orth float64) {
+	n := a.rows
+	r := Mul128(a, vectors)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			r.data[i*n+j].SubAssign(scale(vectors.data[i*n+j], values[j]))
+		}
+	}
+	resid = ttNorm128(r) / math.Max(ttNorm128(a), 1)
+
+	g := Mul128(ConjTranspose128(vectors), vectors)
+	for i := 0; i < n; i++ {
+		g.data[i*n+i].SubAssign(xmath.NewComplex128(1, 0))
+	}
+	return resid, ttNorm128(g)
+}
+
+var ttEigenSizes = []int{0, 1, 2, 3, 4, 5, 8, 16, 33, 64}
+
+func TestEigenHermitian128(t *testing.T) {
+	for _, n := range ttEigenSizes {
+		a := ttHermitian(uint64(n), n)
+		values, vectors, err := EigenHermitian128(a)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		for i := 1; i < n; i++ {
+			if values[i-1] > values[i] {
+				t.Errorf("n=%d: eigenvalues are not sorted: %v", n, values)
+				break
+			}
+		}
+		resid, orth := ttEigenErrors(a, values, vectors)
+		if tol := 1e-14 * float64(n); resid > tol || orth > tol {
+			t.Errorf("n=%d: residual %g, orthogonality error %g exceed %g", n, resid, orth, tol)
+		}
+	}
+}
+
+func TestEigenHermitian(t *testing.T) {
+	for _, n := range ttEigenSizes {
+		a := ttHermitian(uint64(n)+100, n).Dense64()
+		values, vectors, err := EigenHermitian(a)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		values128 := make([]float64, n)
+		for i, v := range values {
+			values128[i] = float64(v)
+		}
+		resid, orth := ttEigenErrors(a.Dense128(), values128, vectors.Dense128())
+		if tol := 1e-6 * math.Max(float64(n), 1); resid > tol || orth > tol {
+			t.Errorf("n=%d: residual %g, orthogonality error %g exceed %g", n, resid, orth, tol)
+		}
+	}
+}
+
+func TestEigenHermitianKnown(t *testing.T) {
+	c := xmath.NewComplex128
+	tests := []struct {
+		n    int
+		a    []xmath.Complex128
+		want []float64
+	}{
+		// Pauli matrices.
+		{2, []xmath.Complex128{c(0, 0), c(1, 0), c(1, 0), c(0, 0)}, []float64{-1, 1}},
+		{2, []xmath.Complex128{c(0, 0), c(0, -1), c(0, 1), c(0, 0)}, []float64{-1, 1}},
+		{2, []xmath.Complex128{c(1, 0), c(0, 0), c(0, 0), c(-1, 0)}, []float64{-1, 1}},
+
+		// Diagonal matrix.
+		{3, []xmath.Complex128{
+			c(3, 0), c(0, 0), c(0, 0),
+			c(0, 0), c(-2, 0), c(0, 0),
+			c(0, 0), c(0, 0), c(1, 0),
+		}, []float64{-2, 1, 3}},
+
+		// Zero matrix and a matrix with repeated eigenvalues.
+		{3, make([]xmath.Complex128, 9), []float64{0, 0, 0}},
+		{3, []xmath.Complex128{
+			c(2, 0), c(0, 1), c(0, 0),
+			c(0, -1), c(2, 0), c(0, 0),
+			c(0, 0), c(0, 0), c(3, 0),
+		}, []float64{1, 3, 3}},
+	}
+
+	for _, test := range tests {
+		a := NewDense128(test.n, test.n, test.a)
+		values, vectors, err := EigenHermitian128(a)
+		if err != nil {
+			t.Fatalf("%v: %v", test.a, err)
+		}
+		for i, want := range test.want {
+			if math.Abs(values[i]-want) > 1e-14 {
+				t.Errorf("%v: eigenvalues mismatch\nwant: %v\nhave: %v", test.a, test.want, values)
+				break
+			}
+		}
+		if resid, orth := ttEigenErrors(a, values, vectors); resid > 1e-14 || orth > 1e-14 {
+			t.Errorf("%v: residual %g, orthogonality error %g", test.a, resid, orth)
+		}
+	}
+}
+
+func TestEigenHermitianLowerTriangle(t *testing.T) {
+	const n = 6
+	a := ttHermitian(1, n)
+	b := a.Clone()
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			b.data[i*n+j] = xmath.NewComplex128(math.NaN(), 0)
+		}
+	}
+	values, vectors, err := EigenHermitian128(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resid, orth := ttEigenErrors(a, values, vectors); resid > 1e-13 || orth > 1e-13 {
+		t.Errorf("residual %g, orthogonality error %g", resid, orth)
+	}
+}
+
+func TestDense128(t *testing.T) {
+	for _, s := range ttShapes {
+		a := ttInput(1, s[0], s[1])
+		b := ttInput(2, s[1], s[2])
+		have := Mul128(a.Dense128(), b.Dense128())
+		want := ttMul128(ttTo128(a.data), ttTo128(b.data), s[0], s[1], s[2])
+		for i, x := range have.data {
+			if x.Real() != real(want[i]) || x.Imag() != imag(want[i]) {
+				t.Errorf("%v: Mul128 differs from builtin at %d", s, i)
+				break
+			}
+		}
+	}
+
+	a := ttInput(3, 3, 5).Dense128()
+	at := ConjTranspose128(a)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 5; j++ {
+			if want, have := a.At(i, j).Conj(), at.At(j, i); !have.Eq(want) {
+				t.Errorf("(%d, %d):\nwant: %v\nhave: %v", j, i, want, have)
+			}
+		}
+	}
+}
+
+func BenchmarkEigenHermitian(b *testing.B) {
+	for _, n := range ttBenchSizes[:3] {
+		a := ttHermitian(1, n).Dense64()
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				EigenHermitian(a)
+			}
+		})
+	}
+}
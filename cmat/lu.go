@@ -0,0 +1,154 @@
+package cmat
+
+import (
+	"errors"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/vec"
+)
+
+// ErrSingular is returned when a matrix can't be inverted
+// because its LU factorization has a zero pivot.
+var ErrSingular = errors.New("cmat: matrix is singular")
+
+// LU64 is the LU factorization of a square matrix A with partial pivoting:
+//
+//	P*A = L*U
+//
+// where P is a permutation matrix, L is a unit lower triangular matrix
+// and U is an upper triangular matrix.
+type LU64 struct {
+	// lu holds L below the diagonal and U on and above it.
+	lu *Dense64
+
+	// pivot[k] is the row that was swapped with row k at step k.
+	pivot []int
+
+	// swaps is the number of row swaps that changed the order.
+	swaps int
+
+	singular bool
+}
+
+// LU computes the LU factorization of a; a is not modified.
+// It panics if a is not square.
+//
+// The pivot of every column is the element with the maximum
+// |real| + |imag| value, like LAPACK cgetrf selects it.
+// A singular matrix is factorized as well; use Det to inspect it.
+func LU(a *Dense64) *LU64 {
+	if a.rows != a.cols {
+		panic(errSquare)
+	}
+	n := a.rows
+	f := &LU64{lu: a.Clone(), pivot: make([]int, n)}
+	data := f.lu.data
+	for k := 0; k < n; k++ {
+		p := k + vec.IamaxInc(n-k, data[k*n+k:], n)
+		f.pivot[k] = p
+		if p != k {
+			kRow, pRow := f.lu.Row(k), f.lu.Row(p)
+			for j := range kRow {
+				kRow[j], pRow[j] = pRow[j], kRow[j]
+			}
+			f.swaps++
+		}
+		pivot := data[k*n+k]
+		if pivot.IsZero() {
+			f.singular = true
+			continue
+		}
+		kRow := f.lu.Row(k)[k+1:]
+		for i := k + 1; i < n; i++ {
+			l := data[i*n+k].Div(pivot)
+			data[i*n+k] = l
+			vec.Axpy(neg(l), kRow, f.lu.Row(i)[k+1:])
+		}
+	}
+	return f
+}
+
+// Det returns the determinant of the factorized matrix.
+//
+// The determinant is the product of the U diagonal elements,
+// so it overflows easily for large matrices.
+func (f *LU64) Det() xmath.Complex64 {
+	det := xmath.NewComplex64(1, 0)
+	if f.swaps%2 != 0 {
+		det = neg(det)
+	}
+	n := f.lu.rows
+	for i := 0; i < n; i++ {
+		det.MulAssign(f.lu.data[i*n+i])
+	}
+	return det
+}
+
+// Solve returns X that satisfies A*X = b.
+// It returns ErrSingular if A is singular.
+func (f *LU64) Solve(b *Dense64) (*Dense64, error) {
+	if b.rows != f.lu.rows {
+		panic(errShape)
+	}
+	if f.singular {
+		return nil, ErrSingular
+	}
+	x := b.Clone()
+	n := f.lu.rows
+	for k, p := range f.pivot {
+		if p != k {
+			kRow, pRow := x.Row(k), x.Row(p)
+			for j := range kRow {
+				kRow[j], pRow[j] = pRow[j], kRow[j]
+			}
+		}
+	}
+	// Forward substitution with unit L.
+	for i := 0; i < n; i++ {
+		row := x.Row(i)
+		for k := 0; k < i; k++ {
+			vec.Axpy(neg(f.lu.data[i*n+k]), x.Row(k), row)
+		}
+	}
+	// Back substitution with U.
+	for i := n - 1; i >= 0; i-- {
+		row := x.Row(i)
+		for k := i + 1; k < n; k++ {
+			vec.Axpy(neg(f.lu.data[i*n+k]), x.Row(k), row)
+		}
+		d := f.lu.data[i*n+i]
+		for j := range row {
+			row[j].DivAssign(d)
+		}
+	}
+	return x, nil
+}
+
+// Inverse returns the inverse of the factorized matrix.
+// It returns ErrSingular if the matrix is singular.
+func (f *LU64) Inverse() (*Dense64, error) {
+	return f.Solve(Identity64(f.lu.rows))
+}
+
+// Solve returns X that satisfies a*X = b.
+// It returns ErrSingular if a is singular.
+func Solve(a, b *Dense64) (*Dense64, error) {
+	return LU(a).Solve(b)
+}
+
+// Inverse returns the inverse of a.
+// It returns ErrSingular if a is singular.
+func Inverse(a *Dense64) (*Dense64, error) {
+	return LU(a).Inverse()
+}
+
+// Det returns the determinant of a.
+func Det(a *Dense64) xmath.Complex64 {
+	return LU(a).Det()
+}
+
+// neg returns -x.
+// Negation is exact, so y += neg(l)*x is the same as y -= l*x.
+func neg(x xmath.Complex64) xmath.Complex64 {
+	return xmath.NewComplex64(-x.Real(), -x.Imag())
+}
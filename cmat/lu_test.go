@@ -0,0 +1,259 @@
+package cmat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// Builtin complex64 implementation of the same algorithms.
+
+type ttLUBuiltin struct {
+	n        int
+	lu       []complex64
+	pivot    []int
+	swaps    int
+	singular bool
+}
+
+func ttFactorizeBuiltin(a []complex64, n int) *ttLUBuiltin {
+	f := &ttLUBuiltin{n: n, lu: append([]complex64(nil), a...), pivot: make([]int, n)}
+	lu := f.lu
+	for k := 0; k < n; k++ {
+		p := k
+		maxSum := -1.0
+		for i := k; i < n; i++ {
+			x := lu[i*n+k]
+			if v := math.Abs(float64(real(x))) + math.Abs(float64(imag(x))); v > maxSum {
+				maxSum = v
+				p = i
+			}
+		}
+		f.pivot[k] = p
+		if p != k {
+			for j := 0; j < n; j++ {
+				lu[k*n+j], lu[p*n+j] = lu[p*n+j], lu[k*n+j]
+			}
+			f.swaps++
+		}
+		pivot := lu[k*n+k]
+		if pivot == 0 {
+			f.singular = true
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			l := lu[i*n+k] / pivot
+			lu[i*n+k] = l
+			for j := k + 1; j < n; j++ {
+				lu[i*n+j] -= l * lu[k*n+j]
+			}
+		}
+	}
+	return f
+}
+
+func (f *ttLUBuiltin) det() complex64 {
+	det := complex64(1)
+	if f.swaps%2 != 0 {
+		det = -det
+	}
+	for i := 0; i < f.n; i++ {
+		det *= f.lu[i*f.n+i]
+	}
+	return det
+}
+
+func (f *ttLUBuiltin) solve(b []complex64, cols int) []complex64 {
+	n := f.n
+	x := append([]complex64(nil), b...)
+	for k, p := range f.pivot {
+		for j := 0; j < cols; j++ {
+			x[k*cols+j], x[p*cols+j] = x[p*cols+j], x[k*cols+j]
+		}
+	}
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			l := f.lu[i*n+k]
+			for j := 0; j < cols; j++ {
+				x[i*cols+j] -= l * x[k*cols+j]
+			}
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			u := f.lu[i*n+k]
+			for j := 0; j < cols; j++ {
+				x[i*cols+j] -= u * x[k*cols+j]
+			}
+		}
+		d := f.lu[i*n+i]
+		for j := 0; j < cols; j++ {
+			x[i*cols+j] /= d
+		}
+	}
+	return x
+}
+
+// ttDet128 computes the determinant of a with complex128 precision.
+func ttDet128(a []complex128, n int) complex128 {
+	lu := append([]complex128(nil), a...)
+	det := complex128(1)
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if ttAbs1(lu[i*n+k]) > ttAbs1(lu[p*n+k]) {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				lu[k*n+j], lu[p*n+j] = lu[p*n+j], lu[k*n+j]
+			}
+			det = -det
+		}
+		det *= lu[k*n+k]
+		if lu[k*n+k] == 0 {
+			return 0
+		}
+		for i := k + 1; i < n; i++ {
+			l := lu[i*n+k] / lu[k*n+k]
+			for j := k + 1; j < n; j++ {
+				lu[i*n+j] -= l * lu[k*n+j]
+			}
+		}
+	}
+	return det
+}
+
+func ttAbs1(x complex128) float64 {
+	return math.Abs(real(x)) + math.Abs(imag(x))
+}
+
+var ttSolveSizes = []int{1, 2, 3, 5, 8, 16, 31, 64}
+
+func TestLUBuiltinEquivalence(t *testing.T) {
+	for _, n := range ttSolveSizes {
+		a := ttInput(uint64(n), n, n)
+		b := ttInput(uint64(n)+100, n, 3)
+
+		f := LU(a)
+		want := ttFactorizeBuiltin(ttToBuiltin(a.data), n)
+		if !ttSame(f.lu.data, want.lu) {
+			t.Errorf("n=%d: LU factors differ from builtin", n)
+		}
+		if have, want := f.Det(), want.det(); !ttSame([]xmath.Complex64{have}, []complex64{want}) {
+			t.Errorf("n=%d: Det differs from builtin:\nwant: %v\nhave: %v", n, want, have)
+		}
+		x, err := f.Solve(b)
+		if err != nil {
+			t.Fatalf("n=%d: Solve: %v", n, err)
+		}
+		if !ttSame(x.data, want.solve(ttToBuiltin(b.data), 3)) {
+			t.Errorf("n=%d: Solve result differs from builtin", n)
+		}
+	}
+}
+
+func TestSolveResidual(t *testing.T) {
+	for _, n := range ttSolveSizes {
+		a := ttInput(uint64(n)+200, n, n)
+		b := ttInput(uint64(n)+300, n, 2)
+		x, err := Solve(a, b)
+		if err != nil {
+			t.Fatalf("n=%d: Solve: %v", n, err)
+		}
+
+		// Normwise backward error: ||A*X - B|| / (||A|| * ||X||).
+		a128, x128 := ttTo128(a.data), ttTo128(x.data)
+		r := ttMul128(a128, x128, n, n, 2)
+		for i, v := range ttTo128(b.data) {
+			r[i] -= v
+		}
+		backward := ttNorm(r) / (ttNorm(a128) * ttNorm(x128))
+		if tol := 1e-6 * float64(n); backward > tol {
+			t.Errorf("n=%d: backward error %g exceeds %g", n, backward, tol)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	for _, n := range ttSolveSizes {
+		a := ttInput(uint64(n)+400, n, n)
+		inv, err := Inverse(a)
+		if err != nil {
+			t.Fatalf("n=%d: Inverse: %v", n, err)
+		}
+		prod := ttMul128(ttTo128(a.data), ttTo128(inv.data), n, n, n)
+		for i := 0; i < n; i++ {
+			prod[i*n+i]--
+		}
+		resid := ttNorm(prod) / (ttNorm(ttTo128(a.data)) * ttNorm(ttTo128(inv.data)))
+		if tol := 1e-6 * float64(n); resid > tol {
+			t.Errorf("n=%d: residual %g exceeds %g", n, resid, tol)
+		}
+	}
+}
+
+func TestDet(t *testing.T) {
+	for _, n := range ttSolveSizes {
+		a := ttInput(uint64(n)+500, n, n)
+		have := Det(a)
+		want := ttDet128(ttTo128(a.data), n)
+		d := complex128(complex(have.Real(), have.Imag())) - want
+		if err := ttNorm([]complex128{d}) / ttNorm([]complex128{want}); err > 1e-5 {
+			t.Errorf("n=%d: relative error %g\nwant: %v\nhave: %v", n, err, want, have)
+		}
+	}
+
+	// Permutation matrix with a single swap.
+	p := NewDense64(3, 3, nil)
+	p.Set(0, 1, xmath.NewComplex64(1, 0))
+	p.Set(1, 0, xmath.NewComplex64(1, 0))
+	p.Set(2, 2, xmath.NewComplex64(0, 2))
+	if have, want := Det(p), xmath.NewComplex64(0, -2); !have.Eq(want) {
+		t.Errorf("permutation:\nwant: %v\nhave: %v", want, have)
+	}
+}
+
+func TestSingular(t *testing.T) {
+	a := ttInput(1, 4, 4)
+	// A zero column makes the pivot exactly zero.
+	for i := 0; i < 4; i++ {
+		a.Set(i, 2, xmath.Complex64{})
+	}
+
+	f := LU(a)
+	if _, err := f.Solve(Identity64(4)); err != ErrSingular {
+		t.Errorf("Solve: want ErrSingular, have %v", err)
+	}
+	if _, err := Inverse(NewDense64(3, 3, nil)); err != ErrSingular {
+		t.Errorf("Inverse(zero): want ErrSingular, have %v", err)
+	}
+	if have := Det(NewDense64(3, 3, nil)); !have.IsZero() {
+		t.Errorf("Det(zero): want 0, have %v", have)
+	}
+}
+
+func BenchmarkLUBuiltin(b *testing.B) {
+	for _, n := range ttBenchSizes {
+		a := ttToBuiltin(ttInput(1, n, n).data)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ttFactorizeBuiltin(a, n)
+			}
+		})
+	}
+}
+
+func BenchmarkLU(b *testing.B) {
+	for _, n := range ttBenchSizes {
+		a := ttInput(1, n, n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				LU(a)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package xmath
+
+import (
+	"cmp"
+	"math"
+)
+
+// Compare returns
+//
+//	-1 if a is less than b,
+//	 0 if a equals b,
+//	+1 if a is greater than b.
+//
+// Complex numbers are ordered lexicographically: by real part
+// first, then by imaginary part. Parts are compared with cmp.Compare,
+// so a NaN part is considered less than any non-NaN part,
+// a NaN part is considered equal to another NaN part,
+// and -0 is equal to +0.
+//
+// Compare defines a total order that can be used with
+// slices.SortFunc and similar functions.
+// For values without NaN parts, Compare(a, b) == 0 iff a.Eq(b).
+func Compare(a, b Complex64) int {
+	if c := cmp.Compare(a.r, b.r); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.i, b.i)
+}
+
+// ByAbs orders complex numbers by their absolute value.
+// Values with equal absolute value are ordered by Compare.
+//
+// Absolute value is computed with float64 precision, so values
+// that have equal Abs() result may still be distinguished.
+// NaN absolute values are less than any other absolute value.
+// Note that a value with an infinite part has infinite absolute value,
+// even if its other part is NaN.
+func ByAbs(a, b Complex64) int {
+	absA := math.Hypot(float64(a.r), float64(a.i))
+	absB := math.Hypot(float64(b.r), float64(b.i))
+	if c := cmp.Compare(absA, absB); c != 0 {
+		return c
+	}
+	return Compare(a, b)
+}
+
+// ByPhase orders complex numbers by their phase in [-Pi, Pi].
+// Values with equal phase are ordered by Compare.
+//
+// Phase is computed with float64 precision.
+// Phase sign follows the imaginary part sign, so a value on the
+// negative real axis with -0 imaginary part has phase -Pi and
+// is ordered before any other non-NaN phase value, while
+// the +0 imaginary part gives it a phase of +Pi.
+// NaN phases are less than any other phase.
+func ByPhase(a, b Complex64) int {
+	phaseA := math.Atan2(float64(a.i), float64(a.r))
+	phaseB := math.Atan2(float64(b.i), float64(b.r))
+	if c := cmp.Compare(phaseA, phaseB); c != 0 {
+		return c
+	}
+	return Compare(a, b)
+}
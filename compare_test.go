@@ -0,0 +1,123 @@
+package xmath
+
+import (
+	"slices"
+	"testing"
+)
+
+func ttComparators() []struct {
+	name string
+	cmp  func(a, b Complex64) int
+} {
+	return []struct {
+		name string
+		cmp  func(a, b Complex64) int
+	}{
+		{"Compare", Compare},
+		{"ByAbs", ByAbs},
+		{"ByPhase", ByPhase},
+	}
+}
+
+func ttOrderingValues64() []Complex64 {
+	var values []Complex64
+	for _, x := range ttSpecialValues64() {
+		values = append(values, ttFromBuiltin64(x))
+	}
+	for _, v := range ttValues[:16] {
+		x, y := ttUnpack64(v)
+		values = append(values, x, y)
+	}
+	return values
+}
+
+func TestStrictWeakOrdering(t *testing.T) {
+	values := ttOrderingValues64()
+	sign := func(x int) int {
+		switch {
+		case x < 0:
+			return -1
+		case x > 0:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	for _, tt := range ttComparators() {
+		for _, a := range values {
+			if tt.cmp(a, a) != 0 {
+				t.Errorf("%s: %v is not equal to itself", tt.name, a)
+			}
+			for _, b := range values {
+				ab := sign(tt.cmp(a, b))
+				ba := sign(tt.cmp(b, a))
+				if ab != -ba {
+					t.Errorf("%s: asymmetry violated for %v and %v", tt.name, a, b)
+				}
+				for _, c := range values {
+					bc := sign(tt.cmp(b, c))
+					ac := sign(tt.cmp(a, c))
+					// Both "less" and "equivalent" relations must be transitive.
+					if ab == bc && ab != ac {
+						t.Fatalf("%s: transitivity violated for %v, %v, %v",
+							tt.name, a, b, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCompareEq(t *testing.T) {
+	values := ttOrderingValues64()
+	hasNaN := func(x Complex64) bool {
+		return isNaN(float64(x.r)) || isNaN(float64(x.i))
+	}
+
+	for _, a := range values {
+		for _, b := range values {
+			if hasNaN(a) || hasNaN(b) {
+				continue
+			}
+			want := a.Eq(b)
+			have := Compare(a, b) == 0
+			if want != have {
+				t.Errorf("`Compare(%v, %v) == 0` failed;\nwant: %v\nhave: %v",
+					a, b, want, have)
+			}
+		}
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	for _, tt := range ttComparators() {
+		values := ttOrderingValues64()
+		slices.Reverse(values)
+		slices.SortFunc(values, tt.cmp)
+		if !slices.IsSortedFunc(values, tt.cmp) {
+			t.Errorf("%s: slice is not sorted after SortFunc", tt.name)
+		}
+	}
+}
+
+func TestCompareOrder(t *testing.T) {
+	nan := ttSpecialParts32[8]
+	tests := []struct {
+		a, b Complex64
+		want int
+	}{
+		{Complex64{r: 1, i: 5}, Complex64{r: 2, i: 0}, -1},
+		{Complex64{r: 2, i: 0}, Complex64{r: 2, i: -1}, +1},
+		{Complex64{r: nan, i: 0}, Complex64{r: -1, i: 0}, -1},
+		{Complex64{r: 0, i: nan}, Complex64{r: 0, i: nan}, 0},
+		{Complex64{r: ttSpecialParts32[1], i: 0}, Complex64{}, 0},
+	}
+
+	for _, tt := range tests {
+		if have := Compare(tt.a, tt.b); have != tt.want {
+			t.Errorf("`Compare(%v, %v)` failed;\nwant: %v\nhave: %v",
+				tt.a, tt.b, tt.want, have)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package xmath
+
+import "math"
+
+// Complex128 implements Go builtin "complex128" type.
+//
+// This type has value semantics, all operations return a
+// new instance of Complex128.
+type Complex128 struct {
+	r float64
+	i float64
+}
+
+// NewComplex128 is "complex(r, i)" operation.
+func NewComplex128(r, i float64) Complex128 {
+	return Complex128{r: r, i: i}
+}
+
+// Complex128 is "complex128(c)" conversion.
+// The conversion is exact.
+func (c Complex64) Complex128() Complex128 {
+	return Complex128{r: float64(c.r), i: float64(c.i)}
+}
+
+// Complex64 is "complex64(c)" conversion.
+// Every part is rounded to the nearest float32 value.
+func (c Complex128) Complex64() Complex64 {
+	return Complex64{r: float32(c.r), i: float32(c.i)}
+}
+
+// Real returns complex number real part.
+func (c Complex128) Real() float64 { return c.r }
+
+// Imag returns complex number imaginary part.
+func (c Complex128) Imag() float64 { return c.i }
+
+// Abs returns the absolute value (also called the modulus) of c.
+func (c Complex128) Abs() float64 {
+	return math.Hypot(c.r, c.i)
+}
+
+// Phase returns the phase (also called the argument) of c.
+// The returned value is in the range [-Pi, Pi].
+func (c Complex128) Phase() float64 {
+	return math.Atan2(c.i, c.r)
+}
+
+// Conj returns the complex conjugate of c.
+func (c Complex128) Conj() Complex128 {
+	return Complex128{r: c.r, i: -c.i}
+}
+
+// IsZero returns true if both c.Real() and c.Imag() return 0.
+func (c Complex128) IsZero() bool {
+	return c == Complex128{}
+}
+
+// Eq is "==" operation.
+func (c Complex128) Eq(x Complex128) bool {
+	return c == x
+}
+
+// Neq is "!=" operation.
+func (c Complex128) Neq(x Complex128) bool {
+	return c != x
+}
+
+// Add is "+" operation.
+func (c Complex128) Add(x Complex128) Complex128 {
+	return Complex128{
+		r: c.r + x.r,
+		i: c.i + x.i,
+	}
+}
+
+// Sub is "-" operation.
+func (c Complex128) Sub(x Complex128) Complex128 {
+	return Complex128{
+		r: c.r - x.r,
+		i: c.i - x.i,
+	}
+}
+
+// Mul is "*" operation.
+func (c Complex128) Mul(x Complex128) Complex128 {
+	return Complex128{
+		r: c.r*x.r - c.i*x.i,
+		i: c.r*x.i + c.i*x.r,
+	}
+}
+
+// Div is "/" operation.
+func (c Complex128) Div(x Complex128) Complex128 {
+	e, f := complex128div(c.r, c.i, x.r, x.i)
+	return Complex128{r: e, i: f}
+}
+
+// complex128div returns complex(r1, i1) / complex(r2, i2).
+func complex128div(r1, i1, r2, i2 float64) (e, f float64) {
+	// The implementation code taken from Go runtime package,
+	// "complex128div" function.
+	// More borrowed code at "xruntime.go".
+
+	// Algorithm for robust complex division as described in
+	// Robert L. Smith: Algorithm 116: Complex division. Commun. ACM 5(8): 435 (1962).
+	if abs(r2) >= abs(i2) {
+		ratio := i2 / r2
+		denom := r2 + ratio*i2
+		e = (r1 + i1*ratio) / denom
+		f = (i1 - r1*ratio) / denom
+	} else {
+		ratio := r2 / i2
+		denom := i2 + ratio*r2
+		e = (r1*ratio + i1) / denom
+		f = (i1*ratio - r1) / denom
+	}
+
+	if isNaN(e) && isNaN(f) {
+		// Correct final result to infinities and zeros if applicable.
+		// Matches C99: ISO/IEC 9899:1999 - G.5.1  Multiplicative operators.
+
+		a, b := r1, i1
+		c, d := r2, i2
+
+		switch {
+		case c == 0 && d == 0 && (!isNaN(a) || !isNaN(b)):
+			e = copysign(inf, c) * a
+			f = copysign(inf, c) * b
+
+		case (isInf(a) || isInf(b)) && isFinite(c) && isFinite(d):
+			a = inf2one(a)
+			b = inf2one(b)
+			e = inf * (a*c + b*d)
+			f = inf * (b*c - a*d)
+
+		case (isInf(c) || isInf(d)) && isFinite(a) && isFinite(b):
+			c = inf2one(c)
+			d = inf2one(d)
+			e = 0 * (a*c + b*d)
+			f = 0 * (b*c - a*d)
+		}
+	}
+
+	return e, f
+}
+
+// AddAssign is "+=" operation.
+func (c *Complex128) AddAssign(x Complex128) {
+	c.r += x.r
+	c.i += x.i
+}
+
+// SubAssign is "-=" operation.
+func (c *Complex128) SubAssign(x Complex128) {
+	c.r -= x.r
+	c.i -= x.i
+}
+
+// MulAssign is "*=" operation.
+func (c *Complex128) MulAssign(x Complex128) {
+	*c = c.Mul(x)
+}
+
+// DivAssign is "/=" operation.
+func (c *Complex128) DivAssign(x Complex128) {
+	*c = c.Div(x)
+}
+
+// MulAddAssign is "+= x*y" operation.
+//
+// The product is rounded to complex128 before the addition,
+// exactly like the builtin expression does.
+func (c *Complex128) MulAddAssign(x, y Complex128) {
+	p := x.Mul(y)
+	c.r += p.r
+	c.i += p.i
+}
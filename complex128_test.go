@@ -0,0 +1,235 @@
+package xmath
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// Helper functions.
+
+// ttUnpack128Builtin converts v parts to float64 and divides them by 3,
+// so the values use the full float64 mantissa.
+func ttUnpack128Builtin(v ttValueSet) (complex128, complex128) {
+	return complex(float64(v.r1)/3, float64(v.i1)/3),
+		complex(float64(v.r2)/3, float64(v.i2)/3)
+}
+
+func ttUnpack128(v ttValueSet) (Complex128, Complex128) {
+	x, y := ttUnpack128Builtin(v)
+	return ttFromBuiltin128(x), ttFromBuiltin128(y)
+}
+
+func ttFromBuiltin128(x complex128) Complex128 {
+	return Complex128{r: real(x), i: imag(x)}
+}
+
+// ttPairs128 returns ttValues pairs along with all pairs
+// of special values.
+func ttPairs128() [][2]complex128 {
+	var pairs [][2]complex128
+	for _, v := range ttValues {
+		x, y := ttUnpack128Builtin(v)
+		pairs = append(pairs, [2]complex128{x, y})
+	}
+	special := ttSpecialValues64()
+	for _, x := range special {
+		for _, y := range special {
+			pairs = append(pairs, [2]complex128{complex128(x), complex128(y)})
+		}
+	}
+	return pairs
+}
+
+// ttSame128 reports whether x and y parts are equal or both are NaN.
+func ttSame128(x, y complex128) bool {
+	same := func(a, b float64) bool {
+		return a == b || (math.IsNaN(a) && math.IsNaN(b))
+	}
+	return same(real(x), real(y)) && same(imag(x), imag(y))
+}
+
+// Unit tests.
+
+func TestComplex128Arith(t *testing.T) {
+	tests := []struct {
+		name      string
+		builtinOp func(x, y complex128) complex128
+		op        func(x, y Complex128) Complex128
+	}{
+		{
+			"+",
+			func(x, y complex128) complex128 { return x + y },
+			Complex128.Add,
+		},
+		{
+			"-",
+			func(x, y complex128) complex128 { return x - y },
+			Complex128.Sub,
+		},
+		{
+			"*",
+			func(x, y complex128) complex128 { return x * y },
+			Complex128.Mul,
+		},
+		{
+			"/",
+			func(x, y complex128) complex128 { return x / y },
+			Complex128.Div,
+		},
+		{
+			"+=",
+			func(x, y complex128) complex128 { x += y; return x },
+			func(x, y Complex128) Complex128 { x.AddAssign(y); return x },
+		},
+		{
+			"-=",
+			func(x, y complex128) complex128 { x -= y; return x },
+			func(x, y Complex128) Complex128 { x.SubAssign(y); return x },
+		},
+		{
+			"*=",
+			func(x, y complex128) complex128 { x *= y; return x },
+			func(x, y Complex128) Complex128 { x.MulAssign(y); return x },
+		},
+		{
+			"/=",
+			func(x, y complex128) complex128 { x /= y; return x },
+			func(x, y Complex128) Complex128 { x.DivAssign(y); return x },
+		},
+		{
+			"+=x*",
+			func(x, y complex128) complex128 { x += x * y; return x },
+			func(x, y Complex128) Complex128 { x.MulAddAssign(x, y); return x },
+		},
+	}
+
+	for _, tt := range tests {
+		for _, pair := range ttPairs128() {
+			x, y := pair[0], pair[1]
+			want := tt.builtinOp(x, y)
+			res := tt.op(ttFromBuiltin128(x), ttFromBuiltin128(y))
+			have := complex(res.r, res.i)
+			if !ttSame128(want, have) {
+				t.Errorf(
+					"`%v%s%v` failed;\nwant: %v\nhave: %v",
+					x, tt.name, y, want, have,
+				)
+			}
+		}
+	}
+}
+
+func TestComplex128Logical(t *testing.T) {
+	for _, pair := range ttPairs128() {
+		x, y := pair[0], pair[1]
+		cx, cy := ttFromBuiltin128(x), ttFromBuiltin128(y)
+		if want, have := x == y, cx.Eq(cy); want != have {
+			t.Errorf("`%v==%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+		}
+		if want, have := x != y, cx.Neq(cy); want != have {
+			t.Errorf("`%v!=%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+		}
+		if want, have := x == 0, cx.IsZero(); want != have {
+			t.Errorf("`iszero(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
+func TestComplex128Unary(t *testing.T) {
+	for _, pair := range ttPairs128() {
+		x := pair[0]
+		cx := ttFromBuiltin128(x)
+		if want, have := cmplx.Abs(x), cx.Abs(); want != have && !(math.IsNaN(want) && math.IsNaN(have)) {
+			t.Errorf("`abs(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+		if want, have := cmplx.Phase(x), cx.Phase(); want != have && !(math.IsNaN(want) && math.IsNaN(have)) {
+			t.Errorf("`phase(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+		res := cx.Conj()
+		if want, have := cmplx.Conj(x), complex(res.r, res.i); !ttSame128(want, have) {
+			t.Errorf("`conj(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
+func TestComplexConversions(t *testing.T) {
+	for _, pair := range ttPairs128() {
+		x := pair[0]
+		res := ttFromBuiltin128(x).Complex64()
+		if want, have := complex64(x), complex(res.r, res.i); !ttSame128(complex128(want), complex128(have)) {
+			t.Errorf("`complex64(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+	for _, x := range ttSpecialValues64() {
+		res := ttFromBuiltin64(x).Complex128()
+		if want, have := complex128(x), complex(res.r, res.i); !ttSame128(want, have) {
+			t.Errorf("`complex128(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
+// Performance tests.
+
+var (
+	ttReal64 float64
+	ttImag64 float64
+)
+
+func benchBuiltin128(n int, fn func(x, y complex128)) {
+	for i := 0; i < n; i++ {
+		for _, v := range ttValues {
+			fn(ttUnpack128Builtin(v))
+		}
+	}
+}
+
+func bench128(n int, fn func(x, y Complex128)) {
+	for i := 0; i < n; i++ {
+		for _, v := range ttValues {
+			fn(ttUnpack128(v))
+		}
+	}
+}
+
+func BenchmarkAdd128Builtin(b *testing.B) {
+	benchBuiltin128(b.N, func(x, y complex128) {
+		ttReal64 = real(x + y + x + y)
+		ttImag64 = imag(y + y + y + y)
+	})
+}
+
+func BenchmarkAdd128(b *testing.B) {
+	bench128(b.N, func(x, y Complex128) {
+		ttReal64 = x.Add(y).Add(x).Add(y).Real()
+		ttImag64 = y.Add(y).Add(y).Add(y).Imag()
+	})
+}
+
+func BenchmarkMul128Builtin(b *testing.B) {
+	benchBuiltin128(b.N, func(x, y complex128) {
+		ttReal64 = real(x * y * x * y)
+		ttImag64 = imag(y * y * y * y)
+	})
+}
+
+func BenchmarkMul128(b *testing.B) {
+	bench128(b.N, func(x, y Complex128) {
+		ttReal64 = x.Mul(y).Mul(x).Mul(y).Real()
+		ttImag64 = y.Mul(y).Mul(y).Mul(y).Imag()
+	})
+}
+
+func BenchmarkDiv128Builtin(b *testing.B) {
+	benchBuiltin128(b.N, func(x, y complex128) {
+		ttReal64 = real(x / y / x / y)
+		ttImag64 = imag(y / y / y / y)
+	})
+}
+
+func BenchmarkDiv128(b *testing.B) {
+	bench128(b.N, func(x, y Complex128) {
+		ttReal64 = x.Div(y).Div(x).Div(y).Real()
+		ttImag64 = y.Div(y).Div(y).Div(y).Imag()
+	})
+}
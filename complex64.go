@@ -1,5 +1,7 @@
 package xmath
 
+import "math"
+
 // Complex64 implements Go builtin "complex64" type.
 //
 // This type has value semantics, all operations return a
@@ -9,12 +11,33 @@ type Complex64 struct {
 	i float32
 }
 
+// NewComplex64 is "complex(r, i)" operation.
+func NewComplex64(r, i float32) Complex64 {
+	return Complex64{r: r, i: i}
+}
+
 // Real returns complex number real part.
 func (c Complex64) Real() float32 { return c.r }
 
 // Imag returns complex number imaginary part.
 func (c Complex64) Imag() float32 { return c.i }
 
+// Abs returns the absolute value (also called the modulus) of c.
+func (c Complex64) Abs() float32 {
+	return float32(math.Hypot(float64(c.r), float64(c.i)))
+}
+
+// Phase returns the phase (also called the argument) of c.
+// The returned value is in the range [-Pi, Pi].
+func (c Complex64) Phase() float32 {
+	return float32(math.Atan2(float64(c.i), float64(c.r)))
+}
+
+// Conj returns the complex conjugate of c.
+func (c Complex64) Conj() Complex64 {
+	return Complex64{r: c.r, i: -c.i}
+}
+
 // IsZero returns true if both c.Real() and c.Imag() return 0.
 func (c Complex64) IsZero() bool {
 	return c == Complex64{}
@@ -60,56 +83,40 @@ func (c Complex64) Mul(x Complex64) Complex64 {
 
 // Div is "/" operation.
 func (c Complex64) Div(x Complex64) Complex64 {
-	// The implementation code taken from Go runtime package,
-	// "complex128div" function.
-	// More borrowed code at "xruntime.go".
+	// Like Go runtime does it, complex64 division
+	// is performed with complex128 precision.
+	e, f := complex128div(float64(c.r), float64(c.i), float64(x.r), float64(x.i))
+	return Complex64{r: float32(e), i: float32(f)}
+}
 
-	r1 := float64(c.r)
-	i1 := float64(c.i)
-	r2 := float64(x.r)
-	i2 := float64(x.i)
+// AddAssign is "+=" operation.
+func (c *Complex64) AddAssign(x Complex64) {
+	c.r += x.r
+	c.i += x.i
+}
 
-	var e, f float64 // complex(e, f) = n/m
-
-	// Algorithm for robust complex division as described in
-	// Robert L. Smith: Algorithm 116: Complex division. Commun. ACM 5(8): 435 (1962).
-	if abs(r2) >= abs(i2) {
-		ratio := i2 / r2
-		denom := r2 + ratio*i2
-		e = (r1 + i1*ratio) / denom
-		f = (i1 - r1*ratio) / denom
-	} else {
-		ratio := r2 / i2
-		denom := i2 + ratio*r2
-		e = (r1*ratio + i1) / denom
-		f = (i1*ratio - r1) / denom
-	}
+// SubAssign is "-=" operation.
+func (c *Complex64) SubAssign(x Complex64) {
+	c.r -= x.r
+	c.i -= x.i
+}
 
-	if isNaN(e) && isNaN(f) {
-		// Correct final result to infinities and zeros if applicable.
-		// Matches C99: ISO/IEC 9899:1999 - G.5.1  Multiplicative operators.
-
-		a, b := r1, i1
-		c, d := r2, i2
-
-		switch {
-		case x.IsZero() && (!isNaN(a) || !isNaN(b)):
-			e = copysign(inf, c) * a
-			f = copysign(inf, c) * b
-
-		case (isInf(a) || isInf(b)) && isFinite(c) && isFinite(d):
-			a = inf2one(a)
-			b = inf2one(b)
-			e = inf * (a*c + b*d)
-			f = inf * (b*c - a*d)
-
-		case (isInf(c) || isInf(d)) && isFinite(a) && isFinite(b):
-			c = inf2one(c)
-			d = inf2one(d)
-			e = 0 * (a*c + b*d)
-			f = 0 * (b*c - a*d)
-		}
-	}
+// MulAssign is "*=" operation.
+func (c *Complex64) MulAssign(x Complex64) {
+	*c = c.Mul(x)
+}
 
-	return Complex64{r: float32(e), i: float32(f)}
+// DivAssign is "/=" operation.
+func (c *Complex64) DivAssign(x Complex64) {
+	*c = c.Div(x)
+}
+
+// MulAddAssign is "+= x*y" operation.
+//
+// The product is rounded to complex64 before the addition,
+// exactly like the builtin expression does.
+func (c *Complex64) MulAddAssign(x, y Complex64) {
+	p := x.Mul(y)
+	c.r += p.r
+	c.i += p.i
 }
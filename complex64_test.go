@@ -5,6 +5,8 @@ import (
 	"testing"
 )
 
+//go:generate go run gen_testdata.go
+
 // Helper functions.
 
 func ttUnpack64Builtin(v ttValueSet) (complex64, complex64) {
@@ -18,9 +20,11 @@ func ttUnpack64(v ttValueSet) (Complex64, Complex64) {
 // Unit tests.
 
 func TestComplex64Arith(t *testing.T) {
+	// Parts are compared separately, since a value
+	// may have both NaN and infinite parts.
 	bothNaN := func(x, y complex64) bool {
-		return cmplx.IsNaN(complex128(x)) &&
-			cmplx.IsNaN(complex128(y))
+		sameOrNaN := func(a, b float32) bool { return a == b || a != a && b != b }
+		return sameOrNaN(real(x), real(y)) && sameOrNaN(imag(x), imag(y))
 	}
 
 	tests := []struct {
@@ -50,6 +54,68 @@ func TestComplex64Arith(t *testing.T) {
 		},
 	}
 
+	values := append(ttValues[:len(ttValues):len(ttValues)], ttXrandValues...)
+	for _, tt := range tests {
+		for _, v := range values {
+			x, y := ttUnpack64Builtin(v)
+			want := tt.builtinOp(x, y)
+			res := tt.op(ttUnpack64(v))
+			have := complex(res.r, res.i)
+			if want != have && !bothNaN(want, have) {
+				t.Errorf(
+					"`%v%s%v` failed;\nwant: %v\nhave: %v",
+					x, tt.name, y, want, have,
+				)
+			}
+		}
+	}
+}
+
+func TestComplex64ArithAssign(t *testing.T) {
+	// Parts are compared separately, since a value
+	// may have both NaN and infinite parts.
+	bothNaN := func(x, y complex64) bool {
+		sameOrNaN := func(a, b float32) bool { return a == b || a != a && b != b }
+		return sameOrNaN(real(x), real(y)) && sameOrNaN(imag(x), imag(y))
+	}
+
+	tests := []struct {
+		name      string
+		builtinOp func(x, y complex64) complex64
+		op        func(x, y Complex64) Complex64
+	}{
+		{
+			"+=",
+			func(x, y complex64) complex64 { x += y; return x },
+			func(x, y Complex64) Complex64 { x.AddAssign(y); return x },
+		},
+		{
+			"-=",
+			func(x, y complex64) complex64 { x -= y; return x },
+			func(x, y Complex64) Complex64 { x.SubAssign(y); return x },
+		},
+		{
+			"*=",
+			func(x, y complex64) complex64 { x *= y; return x },
+			func(x, y Complex64) Complex64 { x.MulAssign(y); return x },
+		},
+		{
+			"/=",
+			func(x, y complex64) complex64 { x /= y; return x },
+			func(x, y Complex64) Complex64 { x.DivAssign(y); return x },
+		},
+		{
+			"+=x*",
+			func(x, y complex64) complex64 { x += x * y; return x },
+			func(x, y Complex64) Complex64 { x.MulAddAssign(x, y); return x },
+		},
+		{
+			"+=y*",
+			func(x, y complex64) complex64 { y += x * y; return y },
+			func(x, y Complex64) Complex64 { y.MulAddAssign(x, y); return y },
+		},
+	}
+
 	for _, tt := range tests {
 		for _, v := range ttValues {
 			x, y := ttUnpack64Builtin(v)
@@ -99,6 +165,58 @@ func TestComplex64Logical(t *testing.T) {
 	}
 }
 
+func TestComplex64AbsPhase(t *testing.T) {
+	tests := []struct {
+		name      string
+		builtinOp func(x complex64) float32
+		op        func(x Complex64) float32
+	}{
+		{
+			"abs",
+			func(x complex64) float32 { return float32(cmplx.Abs(complex128(x))) },
+			Complex64.Abs,
+		},
+		{
+			"phase",
+			func(x complex64) float32 { return float32(cmplx.Phase(complex128(x))) },
+			Complex64.Phase,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, v := range ttValues {
+			x, y := ttUnpack64Builtin(v)
+			cx, cy := ttUnpack64(v)
+			for _, pair := range []struct {
+				builtin complex64
+				lib     Complex64
+			}{{x, cx}, {y, cy}} {
+				want := tt.builtinOp(pair.builtin)
+				have := tt.op(pair.lib)
+				if want != have {
+					t.Errorf(
+						"`%s(%v)` failed;\nwant: %v\nhave: %v",
+						tt.name, pair.builtin, want, have,
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestComplex64Conj(t *testing.T) {
+	for _, v := range ttValues {
+		x, _ := ttUnpack64Builtin(v)
+		cx, _ := ttUnpack64(v)
+		want := complex64(cmplx.Conj(complex128(x)))
+		res := cx.Conj()
+		have := complex(res.r, res.i)
+		if want != have {
+			t.Errorf("`conj(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
 func TestComplex64IsZero(t *testing.T) {
 	bothZeroBuiltin := func(x, y complex64) bool {
 		return x == 0 && y == 0
@@ -130,6 +248,9 @@ var (
 	ttBool2  bool
 	ttBool3  bool
 	ttBool4  bool
+
+	ttAcc64Builtin complex64
+	ttAcc64        Complex64
 )
 
 func benchBuiltin(n int, fn func(x, y complex64)) {
@@ -221,3 +342,118 @@ func BenchmarkDiv64(b *testing.B) {
 		ttImag32 = y.Div(y).Div(y).Div(y).Imag()
 	})
 }
+
+// Compound assignment benchmarks.
+// "Assign" versions use pointer receiver methods,
+// "Value" versions re-assign the result of value receiver methods.
+
+func BenchmarkAddAssign64Builtin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		ttAcc64Builtin += x
+		ttAcc64Builtin += y
+	})
+}
+
+func BenchmarkAddAssign64(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64.AddAssign(x)
+		ttAcc64.AddAssign(y)
+	})
+}
+
+func BenchmarkAddAssign64Value(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = ttAcc64.Add(x)
+		ttAcc64 = ttAcc64.Add(y)
+	})
+}
+
+func BenchmarkSubAssign64Builtin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		ttAcc64Builtin -= x
+		ttAcc64Builtin -= y
+	})
+}
+
+func BenchmarkSubAssign64(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64.SubAssign(x)
+		ttAcc64.SubAssign(y)
+	})
+}
+
+func BenchmarkSubAssign64Value(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = ttAcc64.Sub(x)
+		ttAcc64 = ttAcc64.Sub(y)
+	})
+}
+
+func BenchmarkMulAssign64Builtin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		ttAcc64Builtin = x
+		ttAcc64Builtin *= y
+		ttAcc64Builtin *= x
+	})
+}
+
+func BenchmarkMulAssign64(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = x
+		ttAcc64.MulAssign(y)
+		ttAcc64.MulAssign(x)
+	})
+}
+
+func BenchmarkMulAssign64Value(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = x
+		ttAcc64 = ttAcc64.Mul(y)
+		ttAcc64 = ttAcc64.Mul(x)
+	})
+}
+
+func BenchmarkDivAssign64Builtin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		ttAcc64Builtin = x
+		ttAcc64Builtin /= y
+		ttAcc64Builtin /= x
+	})
+}
+
+func BenchmarkDivAssign64(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = x
+		ttAcc64.DivAssign(y)
+		ttAcc64.DivAssign(x)
+	})
+}
+
+func BenchmarkDivAssign64Value(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = x
+		ttAcc64 = ttAcc64.Div(y)
+		ttAcc64 = ttAcc64.Div(x)
+	})
+}
+
+func BenchmarkMulAddAssign64Builtin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		ttAcc64Builtin += x * y
+		ttAcc64Builtin += y * y
+	})
+}
+
+func BenchmarkMulAddAssign64(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64.MulAddAssign(x, y)
+		ttAcc64.MulAddAssign(y, y)
+	})
+}
+
+func BenchmarkMulAddAssign64Value(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttAcc64 = ttAcc64.Add(x.Mul(y))
+		ttAcc64 = ttAcc64.Add(y.Mul(y))
+	})
+}
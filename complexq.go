@@ -0,0 +1,212 @@
+package xmath
+
+import "math"
+
+// ComplexQ15 is a fixed-point complex number with Q15 parts:
+// int16 values that represent numbers in [-1, 1) with 2^-15 step.
+//
+// Add and Sub saturate instead of wrapping around,
+// Mul rounds the exact product to the nearest representable value.
+// Like Complex64, it has value semantics.
+type ComplexQ15 struct {
+	r int16
+	i int16
+}
+
+// ComplexQ31 is a fixed-point complex number with Q31 parts:
+// int32 values that represent numbers in [-1, 1) with 2^-31 step.
+//
+// See ComplexQ15 for the arithmetic rules.
+type ComplexQ31 struct {
+	r int32
+	i int32
+}
+
+// NewComplexQ15 returns a Q15 complex number with raw r and i parts.
+func NewComplexQ15(r, i int16) ComplexQ15 {
+	return ComplexQ15{r: r, i: i}
+}
+
+// NewComplexQ31 returns a Q31 complex number with raw r and i parts.
+func NewComplexQ31(r, i int32) ComplexQ31 {
+	return ComplexQ31{r: r, i: i}
+}
+
+// ComplexQ15 converts c to Q15 representation.
+// Parts are rounded to the nearest Q15 value (ties to even)
+// with an error of at most 2^-16; out of range parts saturate,
+// NaN parts become 0.
+func (c Complex64) ComplexQ15() ComplexQ15 {
+	return ComplexQ15{
+		r: int16(toFixed(float64(c.r), 15)),
+		i: int16(toFixed(float64(c.i), 15)),
+	}
+}
+
+// ComplexQ31 converts c to Q31 representation.
+// See Complex64.ComplexQ15 for rounding rules.
+func (c Complex64) ComplexQ31() ComplexQ31 {
+	return ComplexQ31{
+		r: int32(toFixed(float64(c.r), 31)),
+		i: int32(toFixed(float64(c.i), 31)),
+	}
+}
+
+// Complex64 converts c to Complex64.
+// The conversion is exact.
+func (c ComplexQ15) Complex64() Complex64 {
+	return Complex64{r: float32(c.r) / (1 << 15), i: float32(c.i) / (1 << 15)}
+}
+
+// Complex64 converts c to Complex64.
+// Every part is rounded to the nearest float32 value.
+func (c ComplexQ31) Complex64() Complex64 {
+	return Complex64{
+		r: float32(float64(c.r) / (1 << 31)),
+		i: float32(float64(c.i) / (1 << 31)),
+	}
+}
+
+// Real returns raw Q15 real part.
+func (c ComplexQ15) Real() int16 { return c.r }
+
+// Imag returns raw Q15 imaginary part.
+func (c ComplexQ15) Imag() int16 { return c.i }
+
+// Real returns raw Q31 real part.
+func (c ComplexQ31) Real() int32 { return c.r }
+
+// Imag returns raw Q31 imaginary part.
+func (c ComplexQ31) Imag() int32 { return c.i }
+
+// Conj returns the complex conjugate of c.
+// The negation saturates: -(-1) is 1-2^-15.
+func (c ComplexQ15) Conj() ComplexQ15 {
+	return ComplexQ15{r: c.r, i: int16(sat(-int64(c.i), 15))}
+}
+
+// Conj returns the complex conjugate of c.
+// The negation saturates: -(-1) is 1-2^-31.
+func (c ComplexQ31) Conj() ComplexQ31 {
+	return ComplexQ31{r: c.r, i: int32(sat(-int64(c.i), 31))}
+}
+
+// IsZero returns true if both c.Real() and c.Imag() return 0.
+func (c ComplexQ15) IsZero() bool {
+	return c == ComplexQ15{}
+}
+
+// IsZero returns true if both c.Real() and c.Imag() return 0.
+func (c ComplexQ31) IsZero() bool {
+	return c == ComplexQ31{}
+}
+
+// Eq is "==" operation.
+func (c ComplexQ15) Eq(x ComplexQ15) bool {
+	return c == x
+}
+
+// Eq is "==" operation.
+func (c ComplexQ31) Eq(x ComplexQ31) bool {
+	return c == x
+}
+
+// Add is saturating "+" operation.
+func (c ComplexQ15) Add(x ComplexQ15) ComplexQ15 {
+	return ComplexQ15{
+		r: int16(sat(int64(c.r)+int64(x.r), 15)),
+		i: int16(sat(int64(c.i)+int64(x.i), 15)),
+	}
+}
+
+// Add is saturating "+" operation.
+func (c ComplexQ31) Add(x ComplexQ31) ComplexQ31 {
+	return ComplexQ31{
+		r: int32(sat(int64(c.r)+int64(x.r), 31)),
+		i: int32(sat(int64(c.i)+int64(x.i), 31)),
+	}
+}
+
+// Sub is saturating "-" operation.
+func (c ComplexQ15) Sub(x ComplexQ15) ComplexQ15 {
+	return ComplexQ15{
+		r: int16(sat(int64(c.r)-int64(x.r), 15)),
+		i: int16(sat(int64(c.i)-int64(x.i), 15)),
+	}
+}
+
+// Sub is saturating "-" operation.
+func (c ComplexQ31) Sub(x ComplexQ31) ComplexQ31 {
+	return ComplexQ31{
+		r: int32(sat(int64(c.r)-int64(x.r), 31)),
+		i: int32(sat(int64(c.i)-int64(x.i), 31)),
+	}
+}
+
+// Mul is "*" operation.
+//
+// Every part is computed exactly and then rounded to the nearest
+// Q15 value (ties toward +Inf), so the error is at most 2^-16.
+// The only unrepresentable results, like (-1-1i)*(-1-1i) = 2i, saturate.
+func (c ComplexQ15) Mul(x ComplexQ15) ComplexQ15 {
+	r1, i1 := int64(c.r), int64(c.i)
+	r2, i2 := int64(x.r), int64(x.i)
+	const half = 1 << 14
+	return ComplexQ15{
+		r: int16(sat((r1*r2-i1*i2+half)>>15, 15)),
+		i: int16(sat((r1*i2+i1*r2+half)>>15, 15)),
+	}
+}
+
+// Mul is "*" operation.
+// See ComplexQ15.Mul for rounding rules; the error is at most 2^-32.
+func (c ComplexQ31) Mul(x ComplexQ31) ComplexQ31 {
+	r1, i1 := int64(c.r), int64(c.i)
+	r2, i2 := int64(x.r), int64(x.i)
+	// Sums of two products may not fit into int64.
+	half := int128{lo: 1 << 30}
+	re := mul128(r1, r2).add(mul128(i1, i2).neg()).add(half).sar(31)
+	im := mul128(r1, i2).add(mul128(i1, r2)).add(half).sar(31)
+	return ComplexQ31{r: int32(sat(re, 31)), i: int32(sat(im, 31))}
+}
+
+// Abs returns an approximation of the absolute value of c,
+// computed without multiplications and square roots as
+//
+//	max(hi, 7/8*hi + 1/2*lo), where hi = max(|re|, |im|), lo = min(|re|, |im|)
+//
+// The relative error is within [-3%, +0.8%], plus 2^-15 of rounding.
+// Results greater than the maximum Q15 value saturate.
+func (c ComplexQ15) Abs() int16 {
+	return int16(sat(absApprox(int64(c.r), int64(c.i)), 15))
+}
+
+// Abs returns an approximation of the absolute value of c.
+// See ComplexQ15.Abs for the error bounds; the rounding error is 2^-31.
+func (c ComplexQ31) Abs() int32 {
+	return int32(sat(absApprox(int64(c.r), int64(c.i)), 31))
+}
+
+// absApprox implements "alpha max plus beta min" magnitude approximation.
+func absApprox(r, i int64) int64 {
+	r = max(r, -r)
+	i = max(i, -i)
+	hi, lo := max(r, i), min(r, i)
+	return max(hi, (7*hi+4*lo+4)>>3)
+}
+
+// sat clamps x to the range of a signed integer with n value bits.
+func sat(x int64, n uint) int64 {
+	return min(max(x, -1<<n), 1<<n-1)
+}
+
+// toFixed converts x to a fixed-point integer with n fraction bits.
+func toFixed(x float64, n uint) int64 {
+	if isNaN(x) {
+		return 0
+	}
+	x = math.RoundToEven(math.Ldexp(x, int(n)))
+	// Clamp before the conversion, out of range conversions are undefined.
+	lim := math.Ldexp(1, int(n))
+	return int64(min(max(x, -lim), lim-1))
+}
@@ -0,0 +1,249 @@
+package xmath
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttQ15Parts returns edge case and random Q15 parts.
+func ttQ15Parts() []int16 {
+	parts := []int16{0, 1, -1, 2, -2, 1 << 14, -(1 << 14), math.MaxInt16, math.MinInt16, math.MaxInt16 - 1, math.MinInt16 + 1}
+	rng := rand.New(rand.NewPCG(42, 0))
+	for i := 0; i < 30; i++ {
+		parts = append(parts, int16(rng.Uint32()))
+	}
+	return parts
+}
+
+// ttQ31Parts returns edge case and random Q31 parts.
+func ttQ31Parts() []int32 {
+	parts := []int32{0, 1, -1, 2, -2, 1 << 30, -(1 << 30), math.MaxInt32, math.MinInt32, math.MaxInt32 - 1, math.MinInt32 + 1}
+	rng := rand.New(rand.NewPCG(42, 1))
+	for i := 0; i < 30; i++ {
+		parts = append(parts, int32(rng.Uint32()))
+	}
+	return parts
+}
+
+// ttClamp returns x saturated to [lo, hi].
+func ttClamp(x, lo, hi float64) float64 {
+	return math.Min(math.Max(x, lo), hi)
+}
+
+// Unit tests.
+
+func TestComplexQ15Arith(t *testing.T) {
+	const lsb = 1.0 / (1 << 15)
+	parts := ttQ15Parts()
+	var values []ComplexQ15
+	for _, r := range parts {
+		for _, i := range parts[:10] {
+			values = append(values, NewComplexQ15(r, i), NewComplexQ15(i, r))
+		}
+	}
+
+	for _, x := range values {
+		for _, y := range values {
+			xr, xi := float64(x.r), float64(x.i)
+			yr, yi := float64(y.r), float64(y.i)
+			tests := []struct {
+				name   string
+				have   ComplexQ15
+				wantRe float64
+				wantIm float64
+			}{
+				{"+", x.Add(y), xr + yr, xi + yi},
+				{"-", x.Sub(y), xr - yr, xi - yi},
+				// Products are exact in float64, the result is rounded half up.
+				{"*", x.Mul(y), math.Floor((xr*yr-xi*yi)*lsb + 0.5), math.Floor((xr*yi+xi*yr)*lsb + 0.5)},
+			}
+			for _, tt := range tests {
+				want := NewComplexQ15(
+					int16(ttClamp(tt.wantRe, math.MinInt16, math.MaxInt16)),
+					int16(ttClamp(tt.wantIm, math.MinInt16, math.MaxInt16)))
+				if tt.have != want {
+					t.Errorf("`%v%s%v` failed;\nwant: %v\nhave: %v", x, tt.name, y, want, tt.have)
+				}
+			}
+		}
+
+		want := NewComplexQ15(x.r, int16(ttClamp(-float64(x.i), math.MinInt16, math.MaxInt16)))
+		if have := x.Conj(); have != want {
+			t.Errorf("conj(%v) failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
+func TestComplexQ31Arith(t *testing.T) {
+	const lsb = 1.0 / (1 << 31)
+	parts := ttQ31Parts()
+	var values []ComplexQ31
+	for _, r := range parts {
+		for _, i := range parts[:10] {
+			values = append(values, NewComplexQ31(r, i), NewComplexQ31(i, r))
+		}
+	}
+
+	for _, x := range values {
+		for _, y := range values {
+			xr, xi := float64(x.r)*lsb, float64(x.i)*lsb
+			yr, yi := float64(y.r)*lsb, float64(y.i)*lsb
+
+			// Add and Sub are exact in float64.
+			want := NewComplexQ31(
+				int32(ttClamp((xr+yr)/lsb, math.MinInt32, math.MaxInt32)),
+				int32(ttClamp((xi+yi)/lsb, math.MinInt32, math.MaxInt32)))
+			if have := x.Add(y); have != want {
+				t.Errorf("`%v+%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+			}
+			want = NewComplexQ31(
+				int32(ttClamp((xr-yr)/lsb, math.MinInt32, math.MaxInt32)),
+				int32(ttClamp((xi-yi)/lsb, math.MinInt32, math.MaxInt32)))
+			if have := x.Sub(y); have != want {
+				t.Errorf("`%v-%v` failed;\nwant: %v\nhave: %v", x, y, want, have)
+			}
+
+			// Float reference of the product has 2^-52 error,
+			// the fixed-point product must be within 2^-32 of it.
+			have := x.Mul(y)
+			wantRe := xr*yr - xi*yi
+			wantIm := xr*yi + xi*yr
+			const bound = lsb/2 + 0x1p-50
+			for _, p := range [][2]float64{{float64(have.r) * lsb, wantRe}, {float64(have.i) * lsb, wantIm}} {
+				saturated := p[1] >= math.MaxInt32*lsb && p[0] == math.MaxInt32*lsb || p[1] < -1 && p[0] == -1
+				if math.Abs(p[0]-p[1]) > bound && !saturated {
+					t.Errorf("`%v*%v` failed;\nwant: %v\nhave: %v", x, y, p[1], p[0])
+				}
+			}
+		}
+	}
+
+	// Sums of products don't fit into int64.
+	x := NewComplexQ31(math.MinInt32, math.MinInt32)
+	if have, want := x.Mul(x.Conj()), NewComplexQ31(math.MaxInt32, 1); have != want {
+		t.Errorf("`%v*%v` failed;\nwant: %v\nhave: %v", x, x.Conj(), want, have)
+	}
+	if have, want := x.Mul(x), NewComplexQ31(0, math.MaxInt32); have != want {
+		t.Errorf("`%v*%v` failed;\nwant: %v\nhave: %v", x, x, want, have)
+	}
+}
+
+func TestComplexQAbs(t *testing.T) {
+	check := func(r, i, have, lsb float64) {
+		want := math.Hypot(r, i)
+		if have == 1-lsb && want >= have {
+			return // Saturated.
+		}
+		if have < 0.97*want-lsb || have > 1.008*want+lsb {
+			t.Errorf("abs(%v, %v) failed;\nwant: %v (-3%%, +0.8%%)\nhave: %v", r, i, want, have)
+		}
+	}
+
+	// Every direction, short and long vectors.
+	for _, m := range []float64{1, 0.5, 0.01, 1e-4} {
+		for k := 0; k < 2000; k++ {
+			s, c := math.Sincos(2 * math.Pi * float64(k) / 2000)
+			x := NewComplex64(float32(m*c), float32(m*s))
+			q15 := x.ComplexQ15()
+			check(float64(q15.r)/(1<<15), float64(q15.i)/(1<<15), float64(q15.Abs())/(1<<15), 1.0/(1<<15))
+			q31 := x.ComplexQ31()
+			check(float64(q31.r)/(1<<31), float64(q31.i)/(1<<31), float64(q31.Abs())/(1<<31), 1.0/(1<<31))
+		}
+	}
+
+	if have := NewComplexQ15(math.MinInt16, math.MinInt16).Abs(); have != math.MaxInt16 {
+		t.Errorf("abs(-1-1i): want saturation, have %v", have)
+	}
+	if have := NewComplexQ31(math.MinInt32, 0).Abs(); have != math.MaxInt32 {
+		t.Errorf("abs(-1): want saturation, have %v", have)
+	}
+}
+
+func TestComplexQConversion(t *testing.T) {
+	// Q15 to Complex64 conversion is exact, so it round trips.
+	for r := math.MinInt16; r <= math.MaxInt16; r++ {
+		x := NewComplexQ15(int16(r), int16(-r/3))
+		if have := x.Complex64().ComplexQ15(); have != x {
+			t.Errorf("Q15 round trip of %v: have %v", x, have)
+		}
+	}
+
+	rng := rand.New(rand.NewPCG(42, 2))
+	for k := 0; k < 10000; k++ {
+		x := NewComplex64(rng.Float32()*2-1, rng.Float32()*2-1)
+		q15 := x.ComplexQ15().Complex64()
+		q31 := x.ComplexQ31().Complex64()
+		for _, p := range [][2]float32{{x.r, q15.r}, {x.i, q15.i}} {
+			if math.Abs(float64(p[0]-p[1])) > 1.0/(1<<16) {
+				t.Errorf("Q15 conversion of %v: have %v", x, q15)
+			}
+		}
+		// Q31 is more precise than float32, the round trip
+		// error comes from the final float32 rounding only.
+		if q31 != x {
+			q := x.ComplexQ31()
+			for _, p := range []float64{float64(x.r) - float64(q.r)/(1<<31), float64(x.i) - float64(q.i)/(1<<31)} {
+				if math.Abs(p) > 1.0/(1<<32) {
+					t.Errorf("Q31 conversion of %v: have %v", x, q)
+				}
+			}
+		}
+	}
+
+	inf := float32(math.Inf(1))
+	nan := float32(math.NaN())
+	tests := []struct {
+		x   Complex64
+		q15 ComplexQ15
+		q31 ComplexQ31
+	}{
+		{NewComplex64(1, -1), NewComplexQ15(math.MaxInt16, math.MinInt16), NewComplexQ31(math.MaxInt32, math.MinInt32)},
+		{NewComplex64(inf, -inf), NewComplexQ15(math.MaxInt16, math.MinInt16), NewComplexQ31(math.MaxInt32, math.MinInt32)},
+		{NewComplex64(nan, 0.5), NewComplexQ15(0, 1<<14), NewComplexQ31(0, 1<<30)},
+		{NewComplex64(0x1p-16, -0x3p-16), NewComplexQ15(0, -2), NewComplexQ31(1<<15, -3<<15)},
+	}
+	for _, test := range tests {
+		if have := test.x.ComplexQ15(); have != test.q15 {
+			t.Errorf("Q15 conversion of %v:\nwant: %v\nhave: %v", test.x, test.q15, have)
+		}
+		if have := test.x.ComplexQ31(); have != test.q31 {
+			t.Errorf("Q31 conversion of %v:\nwant: %v\nhave: %v", test.x, test.q31, have)
+		}
+	}
+}
+
+// Performance tests.
+
+var (
+	ttQ15 ComplexQ15
+	ttQ31 ComplexQ31
+)
+
+func BenchmarkMulQ15(b *testing.B) {
+	xs := make([]ComplexQ15, len(ttValues))
+	for i, v := range ttValues {
+		// Large values saturate, so Mul doesn't degenerate to zeros.
+		xs[i] = NewComplex64(v.r1, v.i2).ComplexQ15()
+	}
+	for i := 0; i < b.N; i++ {
+		for _, x := range xs {
+			ttQ15 = x.Mul(x).Mul(x).Mul(x)
+		}
+	}
+}
+
+func BenchmarkMulQ31(b *testing.B) {
+	xs := make([]ComplexQ31, len(ttValues))
+	for i, v := range ttValues {
+		// Large values saturate, so Mul doesn't degenerate to zeros.
+		xs[i] = NewComplex64(v.r1, v.i2).ComplexQ31()
+	}
+	for i := 0; i < b.N; i++ {
+		for _, x := range xs {
+			ttQ31 = x.Mul(x).Mul(x).Mul(x)
+		}
+	}
+}
@@ -2,13 +2,21 @@
 
 package xmath
 
+import "math"
+
 // This file defines functions for output asm inspection.
 // Functions are single-line to make it possible to grep
 // build -S output by line number.
 //
-// `go build -gcflags -S xruntime.go complex64.go disasm.go 2>&1 | grep 'disasm.go:LINE' | awk '{$1=$2=$3="";print $0}'`
+// `go build -gcflags -S xruntime.go xruntime_unsafe.go complex64.go complex128.go disasm.go 2>&1 | grep 'disasm.go:LINE' | awk '{$1=$2=$3="";print $0}'`
 // OR
-// `go build -o a.out xruntime.go complex64.go disasm.go` + `go tool objdump -s FUNC_NAME a.out | awk '{$1=$3=""; print $0}'`
+// `go build -o a.out xruntime.go xruntime_unsafe.go complex64.go complex128.go disasm.go` + `go tool objdump -s FUNC_NAME a.out | awk '{$1=$3=""; print $0}'`
+//
+// For the purego build, replace xruntime_unsafe.go with xruntime_safe.go:
+//
+// `go build -tags purego -gcflags -S xruntime.go xruntime_safe.go complex64.go complex128.go disasm.go 2>&1 | grep 'disasm.go:LINE' | awk '{$1=$2=$3="";print $0}'`
+// OR
+// `go build -tags purego -o a.out xruntime.go xruntime_safe.go complex64.go complex128.go disasm.go` + `go tool objdump -s FUNC_NAME a.out | awk '{$1=$3=""; print $0}'`
 //
 
 // 0x22b4  REP MOVSS 0x8(SP), X0
@@ -252,3 +260,137 @@ func neq64builtin(c1, c2 complex64) bool { return c1 != c2 }
 // 0x26fc  MOVL CX, AX
 // 0x26fe  JMP 0x26e0
 func neq64(c1, c2 Complex64) bool { return c1.Neq(c2) }
+
+// Functions below were dumped with register-based calling
+// convention (arguments are passed in AX and X0-X3),
+// so there are no stack loads/stores compared to the code above.
+
+// 0x12548  ADDSS 0x4(AX), X1
+// 0x1254d  ADDSS 0(AX), X0
+// 0x12551  MOVSS X0, 0(AX)
+// 0x12555  MOVSS X1, 0x4(AX)
+// 0x1255a  RET
+func addAssign64builtin(c1 *complex64, c2 complex64) { *c1 += c2 }
+
+// 0x1255b  NOPL
+// 0x1255c  ADDSS 0(AX), X0
+// 0x12560  MOVSS X0, 0(AX)
+// 0x12564  ADDSS 0x4(AX), X1
+// 0x12569  MOVSS X1, 0x4(AX)
+// 0x1256e  RET
+func addAssign64(c1 *Complex64, c2 Complex64) { c1.AddAssign(c2) }
+
+// 0x1256f  CVTSS2SD X0, X0
+// 0x12573  CVTSS2SD X2, X2
+// 0x12577  CVTSS2SD X1, X1
+// 0x1257b  MOVUPS X1, X4
+// 0x1257e  MULSD X2, X1
+// 0x12582  CVTSS2SD X3, X3
+// 0x12586  MULSD X3, X4
+// 0x1258a  MULSD X0, X3
+// 0x1258e  ADDSD X1, X3
+// 0x12592  CVTSD2SS X3, X1
+// 0x12596  ADDSS 0x4(AX), X1
+// 0x1259b  MULSD X0, X2
+// 0x1259f  SUBSD X4, X2
+// 0x125a3  CVTSD2SS X2, X0
+// 0x125a7  ADDSS 0(AX), X0
+// 0x125ab  MOVSS X0, 0(AX)
+// 0x125af  MOVSS X1, 0x4(AX)
+// 0x125b4  RET
+func mulAddAssign64builtin(c1 *complex64, c2, c3 complex64) { *c1 += c2 * c3 }
+
+// 0x125b5  NOPL
+// 0x125b6  CVTSS2SD X0, X0
+// 0x125ba  CVTSS2SD X1, X1
+// 0x125be  CVTSS2SD X2, X2
+// 0x125c2  CVTSS2SD X3, X3
+// 0x125c6  MOVUPS X2, X4
+// 0x125c9  MULSD X0, X2
+// 0x125cd  MOVUPS X3, X5
+// 0x125d0  MULSD X1, X3
+// 0x125d4  SUBSD X3, X2
+// 0x125d8  CVTSD2SS X2, X2
+// 0x125dc  ADDSS 0(AX), X2
+// 0x125e0  MULSD X0, X5
+// 0x125e4  MULSD X1, X4
+// 0x125e8  ADDSD X4, X5
+// 0x125ec  CVTSD2SS X5, X0
+// 0x125f0  NOPL
+// 0x125f1  MOVSS X2, 0(AX)
+// 0x125f5  ADDSS 0x4(AX), X0
+// 0x125fa  MOVSS X0, 0x4(AX)
+// 0x125ff  RET
+func mulAddAssign64(c1 *Complex64, c2, c3 Complex64) { c1.MulAddAssign(c2, c3) }
+
+// Bit conversions below are the same with xruntime_safe.go,
+// so the purego build costs nothing.
+// Float32 helpers avoid float64 round trips of the math package.
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVQ X0, AX
+// 0x6  RET
+func float64bitsMath(f float64) uint64 { return math.Float64bits(f) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVQ X0, AX
+// 0x6  RET
+func float64bitsXruntime(f float64) uint64 { return float64bits(f) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVQ AX, X0
+// 0x6  RET
+func float64frombitsMath(b uint64) float64 { return math.Float64frombits(b) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVQ AX, X0
+// 0x6  RET
+func float64frombitsXruntime(b uint64) float64 { return float64frombits(b) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVL X0, AX
+// 0x5  RET
+func float32bitsMath(f float32) uint32 { return math.Float32bits(f) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVL X0, AX
+// 0x5  RET
+func float32bitsXruntime(f float32) uint32 { return float32bits(f) }
+
+// 0x0  CVTSS2SD X0, X1
+// 0x4  MOVQ X1, AX
+// 0x9  BTRQ $63, AX
+// 0xe  MOVQ AX, X1
+// 0x13  CVTSD2SS X1, X0
+// 0x17  RET
+func abs32Math(x float32) float32 { return float32(math.Abs(float64(x))) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVL X0, AX
+// 0x5  ANDL $2147483647, AX
+// 0xa  MOVL AX, X0
+// 0xe  RET
+func abs32Xruntime(x float32) float32 { return abs32(x) }
+
+// 0x0  CVTSS2SD X0, X2
+// 0x4  CVTSS2SD X1, X1
+// 0x8  MOVQ X1, AX
+// 0xd  MOVQ $-9223372036854775808, CX
+// 0x17  ANDQ AX, CX
+// 0x1a  MOVQ X2, AX
+// 0x1f  BTRQ $63, AX
+// 0x24  ORQ CX, AX
+// 0x27  MOVQ AX, X1
+// 0x2c  CVTSD2SS X1, X0
+// 0x30  RET
+func copysign32Math(x, y float32) float32 { return float32(math.Copysign(float64(x), float64(y))) }
+
+// 0x0  XCHGL AX, AX
+// 0x1  MOVL X0, AX
+// 0x5  ANDL $2147483647, AX
+// 0xa  MOVL X1, CX
+// 0xe  ANDL $-2147483648, CX
+// 0x14  ORL CX, AX
+// 0x16  MOVL AX, X0
+// 0x1a  RET
+func copysign32Xruntime(x, y float32) float32 { return copysign32(x, y) }
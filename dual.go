@@ -0,0 +1,173 @@
+package xmath
+
+import "math"
+
+// Dual64 is a dual number a + b*ε with float32 parts, where ε^2 = 0.
+//
+// Evaluating f(x + ε) gives f(x) + f'(x)*ε, so dual numbers
+// can be used for forward-mode automatic differentiation.
+// See Derivative.
+//
+// Add and Sub use float32 arithmetic. Other operations are
+// computed by Dual128 and the result is converted back to float32.
+//
+// This type has value semantics, all operations return a
+// new instance of Dual64.
+type Dual64 struct {
+	a float32
+	b float32
+}
+
+// Dual128 is a dual number a + b*ε with float64 parts.
+// See Dual64 for details.
+type Dual128 struct {
+	a float64
+	b float64
+}
+
+// NewDual64 returns a + b*ε dual number.
+func NewDual64(a, b float32) Dual64 {
+	return Dual64{a: a, b: b}
+}
+
+// NewDual128 returns a + b*ε dual number.
+func NewDual128(a, b float64) Dual128 {
+	return Dual128{a: a, b: b}
+}
+
+// Derivative returns f'(x), computed with dual numbers.
+// x is rounded to float32.
+func Derivative(f func(Dual64) Dual64, x float64) float64 {
+	return float64(f(Dual64{a: float32(x), b: 1}).b)
+}
+
+// Derivative128 returns f'(x), computed with dual numbers.
+func Derivative128(f func(Dual128) Dual128, x float64) float64 {
+	return f(Dual128{a: x, b: 1}).b
+}
+
+// Dual128 converts d to Dual128.
+// The conversion is exact.
+func (d Dual64) Dual128() Dual128 {
+	return Dual128{a: float64(d.a), b: float64(d.b)}
+}
+
+// Dual64 converts d to Dual64.
+// Every part is rounded to the nearest float32 value.
+func (d Dual128) Dual64() Dual64 {
+	return Dual64{a: float32(d.a), b: float32(d.b)}
+}
+
+// Real returns the real part of d.
+func (d Dual64) Real() float32 { return d.a }
+
+// Eps returns the ε coefficient (the derivative part) of d.
+func (d Dual64) Eps() float32 { return d.b }
+
+// Real returns the real part of d.
+func (d Dual128) Real() float64 { return d.a }
+
+// Eps returns the ε coefficient (the derivative part) of d.
+func (d Dual128) Eps() float64 { return d.b }
+
+// Add is "+" operation.
+func (d Dual64) Add(x Dual64) Dual64 {
+	return Dual64{a: d.a + x.a, b: d.b + x.b}
+}
+
+// Sub is "-" operation.
+func (d Dual64) Sub(x Dual64) Dual64 {
+	return Dual64{a: d.a - x.a, b: d.b - x.b}
+}
+
+// Mul is "*" operation.
+func (d Dual64) Mul(x Dual64) Dual64 { return d.Dual128().Mul(x.Dual128()).Dual64() }
+
+// Div is "/" operation.
+func (d Dual64) Div(x Dual64) Dual64 { return d.Dual128().Div(x.Dual128()).Dual64() }
+
+// Exp returns e**d.
+func (d Dual64) Exp() Dual64 { return d.Dual128().Exp().Dual64() }
+
+// Log returns the natural logarithm of d.
+func (d Dual64) Log() Dual64 { return d.Dual128().Log().Dual64() }
+
+// Sin returns the sine of d.
+func (d Dual64) Sin() Dual64 { return d.Dual128().Sin().Dual64() }
+
+// Cos returns the cosine of d.
+func (d Dual64) Cos() Dual64 { return d.Dual128().Cos().Dual64() }
+
+// Sqrt returns the square root of d.
+func (d Dual64) Sqrt() Dual64 { return d.Dual128().Sqrt().Dual64() }
+
+// Pow returns d**x.
+// See Dual128.Pow for details.
+func (d Dual64) Pow(x Dual64) Dual64 { return d.Dual128().Pow(x.Dual128()).Dual64() }
+
+// Add is "+" operation.
+func (d Dual128) Add(x Dual128) Dual128 {
+	return Dual128{a: d.a + x.a, b: d.b + x.b}
+}
+
+// Sub is "-" operation.
+func (d Dual128) Sub(x Dual128) Dual128 {
+	return Dual128{a: d.a - x.a, b: d.b - x.b}
+}
+
+// Mul is "*" operation.
+func (d Dual128) Mul(x Dual128) Dual128 {
+	return Dual128{a: d.a * x.a, b: d.a*x.b + d.b*x.a}
+}
+
+// Div is "/" operation.
+func (d Dual128) Div(x Dual128) Dual128 {
+	q := d.a / x.a
+	return Dual128{a: q, b: (d.b - q*x.b) / x.a}
+}
+
+// Exp returns e**d.
+func (d Dual128) Exp() Dual128 {
+	e := math.Exp(d.a)
+	return Dual128{a: e, b: e * d.b}
+}
+
+// Log returns the natural logarithm of d.
+func (d Dual128) Log() Dual128 {
+	return Dual128{a: math.Log(d.a), b: d.b / d.a}
+}
+
+// Sin returns the sine of d.
+func (d Dual128) Sin() Dual128 {
+	s, c := math.Sincos(d.a)
+	return Dual128{a: s, b: c * d.b}
+}
+
+// Cos returns the cosine of d.
+func (d Dual128) Cos() Dual128 {
+	s, c := math.Sincos(d.a)
+	return Dual128{a: c, b: -s * d.b}
+}
+
+// Sqrt returns the square root of d.
+func (d Dual128) Sqrt() Dual128 {
+	s := math.Sqrt(d.a)
+	return Dual128{a: s, b: d.b / (2 * s)}
+}
+
+// Pow returns d**x.
+//
+// The real part follows math.Pow rules. Terms with zero ε
+// coefficient are skipped, so constant exponents work with
+// negative bases, like in NewDual128(-2, 1).Pow(NewDual128(3, 0)).
+func (d Dual128) Pow(x Dual128) Dual128 {
+	p := math.Pow(d.a, x.a)
+	var b float64
+	if d.b != 0 {
+		b += x.a * math.Pow(d.a, x.a-1) * d.b
+	}
+	if x.b != 0 {
+		b += p * math.Log(d.a) * x.b
+	}
+	return Dual128{a: p, b: b}
+}
@@ -0,0 +1,132 @@
+package xmath
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// Helper functions.
+
+// ttComplexStep returns f'(x) computed with complex-step differentiation:
+// f'(x) = imag(f(x + ih)) / h, which has no subtractive cancellation.
+func ttComplexStep(f func(Complex128) Complex128, x float64) float64 {
+	const h = 1e-30
+	return f(NewComplex128(x, h)).Imag() / h
+}
+
+// ttLift128 turns a builtin complex128 function into a Complex128 one.
+func ttLift128(f func(complex128) complex128) func(Complex128) Complex128 {
+	return func(c Complex128) Complex128 {
+		return ttFromBuiltin128(f(complex(c.Real(), c.Imag())))
+	}
+}
+
+// ttDualFuncs are functions written for all tested types.
+var ttDualFuncs = []struct {
+	name    string
+	dual64  func(Dual64) Dual64
+	dual128 func(Dual128) Dual128
+	complex func(Complex128) Complex128
+}{
+	{
+		"x*exp(sin(x))",
+		func(x Dual64) Dual64 { return x.Mul(x.Sin().Exp()) },
+		func(x Dual128) Dual128 { return x.Mul(x.Sin().Exp()) },
+		func(x Complex128) Complex128 { return x.Mul(ttLift128(cmplx.Exp)(ttLift128(cmplx.Sin)(x))) },
+	},
+	{
+		"log(x)/sqrt(x)",
+		func(x Dual64) Dual64 { return x.Log().Div(x.Sqrt()) },
+		func(x Dual128) Dual128 { return x.Log().Div(x.Sqrt()) },
+		func(x Complex128) Complex128 { return ttLift128(cmplx.Log)(x).Div(ttLift128(cmplx.Sqrt)(x)) },
+	},
+	{
+		"x^x - cos(x)^2.5",
+		func(x Dual64) Dual64 { return x.Pow(x).Sub(x.Cos().Pow(NewDual64(2.5, 0))) },
+		func(x Dual128) Dual128 { return x.Pow(x).Sub(x.Cos().Pow(NewDual128(2.5, 0))) },
+		func(x Complex128) Complex128 {
+			pow := func(x, y Complex128) Complex128 {
+				return ttFromBuiltin128(cmplx.Pow(complex(x.Real(), x.Imag()), complex(y.Real(), y.Imag())))
+			}
+			return pow(x, x).Sub(pow(ttLift128(cmplx.Cos)(x), NewComplex128(2.5, 0)))
+		},
+	},
+	{
+		"(x^3 + 1) / (x - 5)",
+		func(x Dual64) Dual64 {
+			return x.Mul(x).Mul(x).Add(NewDual64(1, 0)).Div(x.Sub(NewDual64(5, 0)))
+		},
+		func(x Dual128) Dual128 {
+			return x.Mul(x).Mul(x).Add(NewDual128(1, 0)).Div(x.Sub(NewDual128(5, 0)))
+		},
+		func(x Complex128) Complex128 {
+			return x.Mul(x).Mul(x).Add(NewComplex128(1, 0)).Div(x.Sub(NewComplex128(5, 0)))
+		},
+	},
+}
+
+// Unit tests.
+
+func TestDerivative(t *testing.T) {
+	for _, fn := range ttDualFuncs {
+		for x := 0.25; x < 1.5; x += 0.125 {
+			want := ttComplexStep(fn.complex, x)
+			scale := math.Max(1, math.Abs(want))
+
+			if have := Derivative128(fn.dual128, x); math.Abs(have-want) > 1e-13*scale {
+				t.Errorf("%s at %v: Derivative128 failed;\nwant: %v\nhave: %v", fn.name, x, want, have)
+			}
+			// Dual64 rounds x and every intermediate result to float32.
+			if have := Derivative(fn.dual64, x); math.Abs(have-want) > 1e-5*scale {
+				t.Errorf("%s at %v: Derivative failed;\nwant: %v\nhave: %v", fn.name, x, want, have)
+			}
+		}
+	}
+}
+
+func TestDualExact(t *testing.T) {
+	tests := []struct {
+		name string
+		have Dual128
+		want Dual128
+	}{
+		{"x*x", NewDual128(3, 1).Mul(NewDual128(3, 1)), NewDual128(9, 6)},
+		{"1/x", NewDual128(1, 0).Div(NewDual128(4, 1)), NewDual128(0.25, -0.0625)},
+		{"(-2)^3", NewDual128(-2, 1).Pow(NewDual128(3, 0)), NewDual128(-8, 12)},
+		{"2^x", NewDual128(2, 0).Pow(NewDual128(3, 1)), NewDual128(8, 8*math.Ln2)},
+		{"sqrt(x)", NewDual128(16, 1).Sqrt(), NewDual128(4, 0.125)},
+		{"exp(0)", NewDual128(0, 2).Exp(), NewDual128(1, 2)},
+		{"log(1)", NewDual128(1, 3).Log(), NewDual128(0, 3)},
+		{"sin(0)", NewDual128(0, 1).Sin(), NewDual128(0, 1)},
+		{"cos(0)", NewDual128(0, 1).Cos(), NewDual128(1, 0)},
+	}
+	for _, tt := range tests {
+		if tt.have != tt.want {
+			t.Errorf("%s failed;\nwant: %v\nhave: %v", tt.name, tt.want, tt.have)
+		}
+		if have, want := tt.have.Dual64(), NewDual64(float32(tt.want.Real()), float32(tt.want.Eps())); have != want {
+			t.Errorf("%s: Dual64 conversion failed;\nwant: %v\nhave: %v", tt.name, want, have)
+		}
+	}
+
+	if have := Derivative(func(x Dual64) Dual64 { return x.Mul(x) }, 3); have != 6 {
+		t.Errorf("d/dx x*x at 3: want 6, have %v", have)
+	}
+}
+
+// Performance tests.
+
+func BenchmarkDerivativeComplexStep(b *testing.B) {
+	f := ttDualFuncs[3].complex
+	for i := 0; i < b.N; i++ {
+		ttReal64 = ttComplexStep(f, 1.5)
+	}
+}
+
+func BenchmarkDerivative(b *testing.B) {
+	f := ttDualFuncs[3].dual128
+	for i := 0; i < b.N; i++ {
+		ttReal64 = Derivative128(f, 1.5)
+	}
+}
@@ -0,0 +1,74 @@
+package fft
+
+import (
+	"math"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// bluestein implements Bluestein's (also known as chirp-z) algorithm.
+//
+// It expresses a DFT of arbitrary length n as a convolution,
+// which is computed with power of two transforms:
+//
+//	X[k] = w[k] * sum (x[j]*w[j]) * conj(w[k-j]),
+//	w[j] = exp(-pi*i*j*j/n)
+type bluestein struct {
+	n int
+
+	// plan is used for convolution, its length is a power of two.
+	plan *Plan
+
+	// chirp[j] = w[j].
+	chirp []xmath.Complex64
+
+	// kernel is the forward transform of conj(w) sequence
+	// that is wrapped around to compute a circular convolution.
+	kernel []xmath.Complex64
+}
+
+func newBluestein(n int) *bluestein {
+	m := 1
+	for m < 2*n-1 {
+		m *= 2
+	}
+	b := &bluestein{
+		n:     n,
+		plan:  NewPlan(m),
+		chirp: make([]xmath.Complex64, n),
+	}
+
+	for j := range b.chirp {
+		// j*j is reduced modulo 2n to keep the angle argument small.
+		jj := (j * j) % (2 * n)
+		sin, cos := math.Sincos(-math.Pi * float64(jj) / float64(n))
+		b.chirp[j] = xmath.NewComplex64(float32(cos), float32(sin))
+	}
+
+	wrapped := make([]xmath.Complex64, m)
+	wrapped[0] = b.chirp[0].Conj()
+	for j := 1; j < n; j++ {
+		wrapped[j] = b.chirp[j].Conj()
+		wrapped[m-j] = b.chirp[j].Conj()
+	}
+	b.kernel = make([]xmath.Complex64, m)
+	b.plan.Forward(b.kernel, wrapped)
+	return b
+}
+
+func (b *bluestein) forward(dst, src []xmath.Complex64) {
+	m := b.plan.Len()
+	a := make([]xmath.Complex64, m)
+	for j, x := range src {
+		a[j] = x.Mul(b.chirp[j])
+	}
+	spectrum := make([]xmath.Complex64, m)
+	b.plan.Forward(spectrum, a)
+	for i := range spectrum {
+		spectrum[i].MulAssign(b.kernel[i])
+	}
+	b.plan.Inverse(a, spectrum)
+	for k := range dst {
+		dst[k] = a[k].Mul(b.chirp[k])
+	}
+}
@@ -0,0 +1,129 @@
+package fft
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// directConvMaxLen is the shorter operand length up to which
+// direct convolution is faster than the FFT-based one.
+const directConvMaxLen = 64
+
+// Convolve returns the full linear convolution of a and b:
+//
+//	c[k] = sum a[j] * b[k-j]
+//
+// The result has len(a)+len(b)-1 elements.
+// Short inputs are convolved directly, long inputs are
+// convolved with FFT.
+func Convolve(a, b []xmath.Complex64) []xmath.Complex64 {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	if min(len(a), len(b)) <= directConvMaxLen {
+		return convolveDirect(a, b)
+	}
+	return convolveFFT(a, b)
+}
+
+// Correlate returns the full cross-correlation of a and b:
+//
+//	c[k] = sum a[j+k-len(b)+1] * conj(b[j])
+//
+// The result has len(a)+len(b)-1 elements; c[len(b)-1]
+// corresponds to the zero lag.
+func Correlate(a, b []xmath.Complex64) []xmath.Complex64 {
+	rev := make([]xmath.Complex64, len(b))
+	for i, x := range b {
+		rev[len(b)-1-i] = x.Conj()
+	}
+	return Convolve(a, rev)
+}
+
+// ConvolveReal is like Convolve, but operates on real sequences.
+func ConvolveReal(a, b []float32) []float32 {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	if min(len(a), len(b)) <= directConvMaxLen {
+		return convolveRealDirect(a, b)
+	}
+	return convolveRealFFT(a, b)
+}
+
+// CorrelateReal is like Correlate, but operates on real sequences.
+func CorrelateReal(a, b []float32) []float32 {
+	rev := make([]float32, len(b))
+	for i, x := range b {
+		rev[len(b)-1-i] = x
+	}
+	return ConvolveReal(a, rev)
+}
+
+func convolveDirect(a, b []xmath.Complex64) []xmath.Complex64 {
+	res := make([]xmath.Complex64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			res[i+j].MulAddAssign(x, y)
+		}
+	}
+	return res
+}
+
+func convolveRealDirect(a, b []float32) []float32 {
+	res := make([]float32, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			res[i+j] += x * y
+		}
+	}
+	return res
+}
+
+func convolveFFT(a, b []xmath.Complex64) []xmath.Complex64 {
+	n := len(a) + len(b) - 1
+	p := NewPlan(fastLen(n))
+	fa := p.transformPadded(a)
+	fb := p.transformPadded(b)
+	for i := range fa {
+		fa[i].MulAssign(fb[i])
+	}
+	res := make([]xmath.Complex64, p.Len())
+	p.Inverse(res, fa)
+	return res[:n]
+}
+
+func convolveRealFFT(a, b []float32) []float32 {
+	n := len(a) + len(b) - 1
+	p := NewRealPlan(fastLen(n))
+	padded := make([]float32, p.Len())
+	fa := make([]xmath.Complex64, p.SpectrumLen())
+	fb := make([]xmath.Complex64, p.SpectrumLen())
+	copy(padded, a)
+	p.Forward(fa, padded)
+	clear(padded)
+	copy(padded, b)
+	p.Forward(fb, padded)
+	for i := range fa {
+		fa[i].MulAssign(fb[i])
+	}
+	p.Inverse(padded, fa)
+	return padded[:n]
+}
+
+// transformPadded returns the forward transform of x padded with zeros.
+func (p *Plan) transformPadded(x []xmath.Complex64) []xmath.Complex64 {
+	padded := make([]xmath.Complex64, p.n)
+	copy(padded, x)
+	dst := make([]xmath.Complex64, p.n)
+	p.forward(dst, padded)
+	return dst
+}
+
+// fastLen returns the smallest power of two that is not less than n.
+func fastLen(n int) int {
+	m := 1
+	for m < n {
+		m *= 2
+	}
+	return m
+}
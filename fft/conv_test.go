@@ -0,0 +1,343 @@
+package fft
+
+import (
+	"fmt"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// ttConvolve computes the full linear convolution of a and b
+// with complex128 precision.
+func ttConvolve(a, b []xmath.Complex64) []complex128 {
+	res := make([]complex128, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			res[i+j] += complex128(complex(x.Real(), x.Imag())) * complex128(complex(y.Real(), y.Imag()))
+		}
+	}
+	return res
+}
+
+// ttCorrelate computes the full cross-correlation of a and b
+// with complex128 precision.
+func ttCorrelate(a, b []xmath.Complex64) []complex128 {
+	res := make([]complex128, len(a)+len(b)-1)
+	for k := range res {
+		lag := k - (len(b) - 1)
+		for j, y := range b {
+			if i := j + lag; i >= 0 && i < len(a) {
+				x := a[i]
+				res[k] += complex128(complex(x.Real(), x.Imag())) * complex128(complex(y.Real(), -y.Imag()))
+			}
+		}
+	}
+	return res
+}
+
+func ttFromReal(xs []float32) []xmath.Complex64 {
+	res := make([]xmath.Complex64, len(xs))
+	for i, x := range xs {
+		res[i] = xmath.NewComplex64(x, 0)
+	}
+	return res
+}
+
+var ttConvLengths = [][2]int{
+	{1, 1}, {1, 9}, {3, 5}, {16, 16}, {64, 100}, {65, 65},
+	{200, 37}, {100, 300}, {513, 511},
+}
+
+func TestConvolve(t *testing.T) {
+	impls := []struct {
+		name string
+		fn   func(a, b []xmath.Complex64) []xmath.Complex64
+	}{
+		{"Convolve", Convolve},
+		{"direct", convolveDirect},
+		{"fft", convolveFFT},
+	}
+	for _, impl := range impls {
+		for _, l := range ttConvLengths {
+			a := ttInput(uint64(l[0]), l[0])
+			b := ttInput(uint64(l[1])+1000, l[1])
+			want := ttConvolve(a, b)
+			have := impl.fn(a, b)
+			if len(have) != len(want) {
+				t.Fatalf("%s(%d, %d): want %d elements, have %d",
+					impl.name, l[0], l[1], len(want), len(have))
+			}
+			if err := ttRelError(have, want); err > 1e-6 {
+				t.Errorf("%s(%d, %d): relative error %g", impl.name, l[0], l[1], err)
+			}
+		}
+	}
+}
+
+func TestCorrelate(t *testing.T) {
+	for _, l := range ttConvLengths {
+		a := ttInput(uint64(l[0]), l[0])
+		b := ttInput(uint64(l[1])+1000, l[1])
+		if err := ttRelError(Correlate(a, b), ttCorrelate(a, b)); err > 1e-6 {
+			t.Errorf("Correlate(%d, %d): relative error %g", l[0], l[1], err)
+		}
+	}
+}
+
+func TestConvolveReal(t *testing.T) {
+	impls := []struct {
+		name string
+		fn   func(a, b []float32) []float32
+	}{
+		{"ConvolveReal", ConvolveReal},
+		{"direct", convolveRealDirect},
+		{"fft", convolveRealFFT},
+	}
+	for _, impl := range impls {
+		for _, l := range ttConvLengths {
+			a := ttRealInput(uint64(l[0]), l[0])
+			b := ttRealInput(uint64(l[1])+1000, l[1])
+			want := ttConvolve(ttFromReal(a), ttFromReal(b))
+			have := impl.fn(a, b)
+			if len(have) != len(want) {
+				t.Fatalf("%s(%d, %d): want %d elements, have %d",
+					impl.name, l[0], l[1], len(want), len(have))
+			}
+			if err := ttRelError(ttFromReal(have), want); err > 1e-6 {
+				t.Errorf("%s(%d, %d): relative error %g", impl.name, l[0], l[1], err)
+			}
+		}
+	}
+}
+
+func TestCorrelateReal(t *testing.T) {
+	for _, l := range ttConvLengths {
+		a := ttRealInput(uint64(l[0]), l[0])
+		b := ttRealInput(uint64(l[1])+1000, l[1])
+		want := ttCorrelate(ttFromReal(a), ttFromReal(b))
+		if err := ttRelError(ttFromReal(CorrelateReal(a, b)), want); err > 1e-6 {
+			t.Errorf("CorrelateReal(%d, %d): relative error %g", l[0], l[1], err)
+		}
+	}
+}
+
+func TestConvolveEmpty(t *testing.T) {
+	x := ttInput(1, 10)
+	if res := Convolve(x, nil); res != nil {
+		t.Errorf("Convolve(x, nil): want nil, have %v", res)
+	}
+	if res := ConvolveReal(nil, []float32{1}); res != nil {
+		t.Errorf("ConvolveReal(nil, x): want nil, have %v", res)
+	}
+}
+
+// ttStreamCases are (filter length, block length) pairs.
+// Zero block length selects it automatically.
+var ttStreamCases = [][2]int{
+	{1, 1}, {1, 16}, {7, 1}, {7, 5}, {7, 0}, {33, 8}, {33, 100}, {100, 0},
+}
+
+const ttStreamBlocks = 9
+
+func TestOverlapAdd(t *testing.T) {
+	for _, c := range ttStreamCases {
+		h := ttInput(uint64(c[0]), c[0])
+		f := NewOverlapAdd(h, c[1])
+		x := ttInput(uint64(c[1])+1000, ttStreamBlocks*f.BlockLen())
+
+		have := make([]xmath.Complex64, len(x)+len(h)-1)
+		for i := 0; i < len(x); i += f.BlockLen() {
+			f.Process(have[i:i+f.BlockLen()], x[i:i+f.BlockLen()])
+		}
+		f.Flush(have[len(x):])
+		if err := ttRelError(have, ttConvolve(x, h)); err > 1e-6 {
+			t.Errorf("h=%d block=%d: relative error %g", c[0], f.BlockLen(), err)
+		}
+	}
+}
+
+func TestOverlapSave(t *testing.T) {
+	for _, c := range ttStreamCases {
+		h := ttInput(uint64(c[0]), c[0])
+		f := NewOverlapSave(h, c[1])
+		x := ttInput(uint64(c[1])+1000, ttStreamBlocks*f.BlockLen())
+
+		have := make([]xmath.Complex64, len(x))
+		for i := 0; i < len(x); i += f.BlockLen() {
+			f.Process(have[i:i+f.BlockLen()], x[i:i+f.BlockLen()])
+		}
+		want := ttConvolve(x, h)[:len(x)]
+		if err := ttRelError(have, want); err > 1e-6 {
+			t.Errorf("h=%d block=%d: relative error %g", c[0], f.BlockLen(), err)
+		}
+	}
+}
+
+func TestOverlapReal(t *testing.T) {
+	for _, c := range ttStreamCases {
+		h := ttRealInput(uint64(c[0]), c[0])
+		ola := NewOverlapAddReal(h, c[1])
+		ols := NewOverlapSaveReal(h, c[1])
+		blockLen := ola.BlockLen()
+		x := ttRealInput(uint64(c[1])+1000, ttStreamBlocks*blockLen)
+		want := ttConvolve(ttFromReal(x), ttFromReal(h))
+
+		haveAdd := make([]float32, len(x)+len(h)-1)
+		haveSave := make([]float32, len(x))
+		for i := 0; i < len(x); i += blockLen {
+			ola.Process(haveAdd[i:i+blockLen], x[i:i+blockLen])
+			ols.Process(haveSave[i:i+blockLen], x[i:i+blockLen])
+		}
+		ola.Flush(haveAdd[len(x):])
+		if err := ttRelError(ttFromReal(haveAdd), want); err > 1e-6 {
+			t.Errorf("OverlapAddReal h=%d block=%d: relative error %g", c[0], blockLen, err)
+		}
+		if err := ttRelError(ttFromReal(haveSave), want[:len(x)]); err > 1e-6 {
+			t.Errorf("OverlapSaveReal h=%d block=%d: relative error %g", c[0], blockLen, err)
+		}
+	}
+}
+
+func TestOverlapReset(t *testing.T) {
+	h := ttInput(1, 10)
+	x := ttInput(2, 20)
+	want := ttConvolve(x, h)[:len(x)]
+
+	f := NewOverlapSave(h, len(x))
+	have := make([]xmath.Complex64, len(x))
+	f.Process(have, x)
+	f.Reset()
+	f.Process(have, x)
+	if err := ttRelError(have, want); err > 1e-6 {
+		t.Errorf("OverlapSave after Reset: relative error %g", err)
+	}
+
+	g := NewOverlapAdd(h, len(x))
+	g.Process(have, x)
+	g.Flush(make([]xmath.Complex64, len(h)-1))
+	g.Process(have, x)
+	if err := ttRelError(have, want); err > 1e-6 {
+		t.Errorf("OverlapAdd after Flush: relative error %g", err)
+	}
+}
+
+func TestOverlapAllocs(t *testing.T) {
+	h := ttInput(1, 33)
+	hr := ttRealInput(1, 33)
+	ola := NewOverlapAdd(h, 0)
+	ols := NewOverlapSave(h, 0)
+	olar := NewOverlapAddReal(hr, 0)
+	olsr := NewOverlapSaveReal(hr, 0)
+	x := ttInput(2, ola.BlockLen())
+	xr := ttRealInput(2, olar.BlockLen())
+	dst := make([]xmath.Complex64, ola.BlockLen())
+	dstr := make([]float32, olar.BlockLen())
+	tail := make([]float32, len(hr)-1)
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"OverlapAdd", func() { ola.Process(dst, x) }},
+		{"OverlapSave", func() { ols.Process(dst, x) }},
+		{"OverlapAddReal", func() { olar.Process(dstr, xr); olar.Flush(tail) }},
+		{"OverlapSaveReal", func() { olsr.Process(dstr, xr) }},
+	}
+	for _, test := range tests {
+		if allocs := testing.AllocsPerRun(10, test.fn); allocs != 0 {
+			t.Errorf("%s: %v allocations per block", test.name, allocs)
+		}
+	}
+}
+
+func TestOverlapPanics(t *testing.T) {
+	h := ttInput(1, 4)
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"empty filter", func() { NewOverlapAdd(nil, 8) }},
+		{"short block", func() {
+			NewOverlapAdd(h, 8).Process(make([]xmath.Complex64, 8), make([]xmath.Complex64, 7))
+		}},
+		{"short dst", func() {
+			NewOverlapSave(h, 8).Process(make([]xmath.Complex64, 7), make([]xmath.Complex64, 8))
+		}},
+		{"short flush", func() {
+			NewOverlapAdd(h, 8).Flush(make([]xmath.Complex64, 2))
+		}},
+		{"short real block", func() {
+			NewOverlapSaveReal([]float32{1, 2}, 8).Process(make([]float32, 8), make([]float32, 9))
+		}},
+		{"short real flush", func() {
+			NewOverlapAddReal([]float32{1, 2, 3}, 8).Flush(make([]float32, 1))
+		}},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+var ttConvBenchLengths = [][2]int{{1000, 16}, {1000, 64}, {1000, 256}, {4096, 1024}}
+
+func BenchmarkConvolveBuiltin(b *testing.B) {
+	for _, l := range ttConvBenchLengths {
+		x := ttToBuiltin(ttInput(1, l[0]))
+		h := ttToBuiltin(ttInput(2, l[1]))
+		b.Run(fmt.Sprintf("%dx%d", l[0], l[1]), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res := make([]complex64, len(x)+len(h)-1)
+				for i, u := range x {
+					for j, v := range h {
+						res[i+j] += u * v
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkConvolve(b *testing.B) {
+	for _, l := range ttConvBenchLengths {
+		x := ttInput(1, l[0])
+		h := ttInput(2, l[1])
+		b.Run(fmt.Sprintf("%dx%d", l[0], l[1]), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Convolve(x, h)
+			}
+		})
+	}
+}
+
+func BenchmarkOverlapSave(b *testing.B) {
+	for _, m := range []int{16, 64, 256} {
+		f := NewOverlapSave(ttInput(2, m), 0)
+		x := ttInput(1, f.BlockLen())
+		dst := make([]xmath.Complex64, f.BlockLen())
+		b.Run(fmt.Sprint(m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f.Process(dst, x)
+			}
+		})
+	}
+}
+
+func BenchmarkOverlapSaveReal(b *testing.B) {
+	for _, m := range []int{16, 64, 256} {
+		f := NewOverlapSaveReal(ttRealInput(2, m), 0)
+		x := ttRealInput(1, f.BlockLen())
+		dst := make([]float32, f.BlockLen())
+		b.Run(fmt.Sprint(m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f.Process(dst, x)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Package fft implements fast Fourier transform of Complex64 sequences.
+//
+// Forward transform computes
+//
+//	X[k] = sum x[j] * exp(-2*pi*i*j*k/n)
+//
+// and inverse transform computes
+//
+//	x[j] = 1/n * sum X[k] * exp(+2*pi*i*j*k/n)
+//
+// so Inverse(Forward(x)) approximates x.
+//
+// Lengths that factor into small primes are transformed with the
+// mixed-radix Cooley-Tukey algorithm, other lengths are
+// transformed with Bluestein's algorithm.
+package fft
+
+import (
+	"math"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// maxRadix is the largest prime factor that is handled by the
+// mixed-radix algorithm directly. Lengths with larger prime
+// factors are handled by Bluestein's algorithm.
+const maxRadix = 31
+
+// Plan holds precomputed data for transforms of a fixed length.
+//
+// A Plan can be re-used for any number of transforms.
+// It's safe to use a Plan concurrently.
+type Plan struct {
+	n int
+
+	// factors are radixes for every Cooley-Tukey step.
+	factors []int
+
+	// twiddles[k] = exp(-2*pi*i*k/n).
+	twiddles []xmath.Complex64
+
+	bluestein *bluestein
+}
+
+// NewPlan returns a Plan for transforms of length n.
+// It panics if n is not positive.
+func NewPlan(n int) *Plan {
+	if n <= 0 {
+		panic("fft: non-positive length")
+	}
+	p := &Plan{n: n}
+	factors := factorize(n)
+	if factors[len(factors)-1] > maxRadix {
+		p.bluestein = newBluestein(n)
+		return p
+	}
+	p.factors = factors
+	p.twiddles = makeTwiddles(n)
+	return p
+}
+
+// Len returns the transform length.
+func (p *Plan) Len() int { return p.n }
+
+// Forward computes the forward transform of src into dst.
+// dst and src must have Len() elements and must not overlap.
+func (p *Plan) Forward(dst, src []xmath.Complex64) {
+	p.checkArgs(dst, src)
+	p.forward(dst, src)
+}
+
+// Inverse computes the inverse transform of src into dst,
+// including the 1/n scaling.
+// dst and src must have Len() elements and must not overlap.
+func (p *Plan) Inverse(dst, src []xmath.Complex64) {
+	p.checkArgs(dst, src)
+	p.inverse(dst, src, make([]xmath.Complex64, p.n))
+}
+
+// inverse is like Inverse, but uses tmp of Len() elements
+// as a scratch space; tmp may be the same slice as src.
+func (p *Plan) inverse(dst, src, tmp []xmath.Complex64) {
+	// ifft(x) = conj(fft(conj(x))) / n.
+	for i, x := range src {
+		tmp[i] = x.Conj()
+	}
+	p.forward(dst, tmp)
+	scale := 1 / float32(p.n)
+	for i, x := range dst {
+		dst[i] = xmath.NewComplex64(x.Real()*scale, -x.Imag()*scale)
+	}
+}
+
+func (p *Plan) checkArgs(dst, src []xmath.Complex64) {
+	if len(dst) != p.n || len(src) != p.n {
+		panic("fft: slice length mismatch")
+	}
+}
+
+func (p *Plan) forward(dst, src []xmath.Complex64) {
+	if p.bluestein != nil {
+		p.bluestein.forward(dst, src)
+		return
+	}
+	var scratch [maxRadix]xmath.Complex64
+	p.transform(dst, src, 1, p.factors, scratch[:])
+}
+
+// transform computes the forward transform of the sequence
+// src[0], src[stride], src[2*stride], ... into dst.
+// The sequence length is len(dst).
+func (p *Plan) transform(dst, src []xmath.Complex64, stride int, factors []int, scratch []xmath.Complex64) {
+	n := len(dst)
+	if n == 1 {
+		dst[0] = src[0]
+		return
+	}
+
+	radix := factors[0]
+	m := n / radix
+	// Decimation in time: transform every radix-th subsequence.
+	for q := 0; q < radix; q++ {
+		p.transform(dst[q*m:(q+1)*m], src[q*stride:], stride*radix, factors[1:], scratch)
+	}
+
+	// twiddles are computed for p.n, so the step for n is p.n/n.
+	twStep := p.n / n
+	if radix == 2 {
+		for k := 0; k < m; k++ {
+			t := dst[m+k].Mul(p.twiddles[k*twStep])
+			dst[m+k] = dst[k].Sub(t)
+			dst[k] = dst[k].Add(t)
+		}
+		return
+	}
+
+	// Generic radix butterfly: for every k, compute radix outputs
+	// dst[u*m+k] = sum dst[q*m+k] * w^(q*(u*m+k)) for q in [0, radix).
+	tmp := scratch[:radix]
+	for k := 0; k < m; k++ {
+		for q := range tmp {
+			tmp[q] = dst[q*m+k]
+		}
+		for u := 0; u < radix; u++ {
+			j := u*m + k
+			sum := tmp[0]
+			for q := 1; q < radix; q++ {
+				sum.MulAddAssign(tmp[q], p.twiddles[(q*j%n)*twStep])
+			}
+			dst[j] = sum
+		}
+	}
+}
+
+// Forward returns the forward transform of x.
+func Forward(x []xmath.Complex64) []xmath.Complex64 {
+	dst := make([]xmath.Complex64, len(x))
+	if len(x) != 0 {
+		NewPlan(len(x)).Forward(dst, x)
+	}
+	return dst
+}
+
+// Inverse returns the inverse transform of x.
+func Inverse(x []xmath.Complex64) []xmath.Complex64 {
+	dst := make([]xmath.Complex64, len(x))
+	if len(x) != 0 {
+		NewPlan(len(x)).Inverse(dst, x)
+	}
+	return dst
+}
+
+// factorize returns n prime factors in ascending order.
+// It returns [1] for n=1.
+func factorize(n int) []int {
+	if n == 1 {
+		return []int{1}
+	}
+	var factors []int
+	for f := 2; f*f <= n; f++ {
+		for n%f == 0 {
+			factors = append(factors, f)
+			n /= f
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors
+}
+
+// makeTwiddles returns exp(-2*pi*i*k/n) for k in [0, n).
+// Values are computed with float64 precision and then rounded.
+func makeTwiddles(n int) []xmath.Complex64 {
+	tw := make([]xmath.Complex64, n)
+	for k := range tw {
+		sin, cos := math.Sincos(-2 * math.Pi * float64(k) / float64(n))
+		tw[k] = xmath.NewComplex64(float32(cos), float32(sin))
+	}
+	return tw
+}
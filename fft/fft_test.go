@@ -0,0 +1,282 @@
+package fft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+// Helper functions.
+
+func ttInput(seed uint64, n int) []xmath.Complex64 {
+	r := xrand.New(seed)
+	xs := make([]xmath.Complex64, n)
+	xrand.Fill(xs, func() xmath.Complex64 { return r.Disk(1) })
+	return xs
+}
+
+func ttToBuiltin(xs []xmath.Complex64) []complex64 {
+	res := make([]complex64, len(xs))
+	for i, x := range xs {
+		res[i] = complex(x.Real(), x.Imag())
+	}
+	return res
+}
+
+// ttDFT computes the forward (sign=-1) or unscaled inverse (sign=+1)
+// DFT of x with complex128 precision.
+func ttDFT(x []xmath.Complex64, sign float64) []complex128 {
+	n := len(x)
+	res := make([]complex128, n)
+	for k := range res {
+		var sum complex128
+		for j, v := range x {
+			angle := sign * 2 * math.Pi * float64(j*k%n) / float64(n)
+			sum += complex128(complex(v.Real(), v.Imag())) * cmplx.Rect(1, angle)
+		}
+		res[k] = sum
+	}
+	return res
+}
+
+// ttRelError returns ||have - want|| / ||want||.
+func ttRelError(have []xmath.Complex64, want []complex128) float64 {
+	var diff, norm float64
+	for i := range want {
+		d := complex128(complex(have[i].Real(), have[i].Imag())) - want[i]
+		diff += real(d)*real(d) + imag(d)*imag(d)
+		norm += real(want[i])*real(want[i]) + imag(want[i])*imag(want[i])
+	}
+	return math.Sqrt(diff / norm)
+}
+
+// Builtin complex64 implementation of the same algorithms.
+// It performs exactly the same operations in the same order.
+
+type ttPlanBuiltin struct {
+	n         int
+	factors   []int
+	twiddles  []complex64
+	bluestein *ttBluesteinBuiltin
+}
+
+type ttBluesteinBuiltin struct {
+	plan   *ttPlanBuiltin
+	chirp  []complex64
+	kernel []complex64
+}
+
+func ttNewPlanBuiltin(p *Plan) *ttPlanBuiltin {
+	res := &ttPlanBuiltin{
+		n:        p.n,
+		factors:  p.factors,
+		twiddles: ttToBuiltin(p.twiddles),
+	}
+	if p.bluestein != nil {
+		res.bluestein = &ttBluesteinBuiltin{
+			plan:   ttNewPlanBuiltin(p.bluestein.plan),
+			chirp:  ttToBuiltin(p.bluestein.chirp),
+			kernel: ttToBuiltin(p.bluestein.kernel),
+		}
+	}
+	return res
+}
+
+func (p *ttPlanBuiltin) forward(dst, src []complex64) {
+	if b := p.bluestein; b != nil {
+		m := b.plan.n
+		a := make([]complex64, m)
+		for j, x := range src {
+			a[j] = x * b.chirp[j]
+		}
+		spectrum := make([]complex64, m)
+		b.plan.forward(spectrum, a)
+		for i := range spectrum {
+			spectrum[i] *= b.kernel[i]
+		}
+		b.plan.inverse(a, spectrum)
+		for k := range dst {
+			dst[k] = a[k] * b.chirp[k]
+		}
+		return
+	}
+	var scratch [maxRadix]complex64
+	p.transform(dst, src, 1, p.factors, scratch[:])
+}
+
+func (p *ttPlanBuiltin) inverse(dst, src []complex64) {
+	tmp := make([]complex64, p.n)
+	for i, x := range src {
+		tmp[i] = complex(real(x), -imag(x))
+	}
+	p.forward(dst, tmp)
+	scale := 1 / float32(p.n)
+	for i, x := range dst {
+		dst[i] = complex(real(x)*scale, -imag(x)*scale)
+	}
+}
+
+func (p *ttPlanBuiltin) transform(dst, src []complex64, stride int, factors []int, scratch []complex64) {
+	n := len(dst)
+	if n == 1 {
+		dst[0] = src[0]
+		return
+	}
+	radix := factors[0]
+	m := n / radix
+	for q := 0; q < radix; q++ {
+		p.transform(dst[q*m:(q+1)*m], src[q*stride:], stride*radix, factors[1:], scratch)
+	}
+	twStep := p.n / n
+	if radix == 2 {
+		for k := 0; k < m; k++ {
+			t := dst[m+k] * p.twiddles[k*twStep]
+			dst[m+k] = dst[k] - t
+			dst[k] = dst[k] + t
+		}
+		return
+	}
+	tmp := scratch[:radix]
+	for k := 0; k < m; k++ {
+		for q := range tmp {
+			tmp[q] = dst[q*m+k]
+		}
+		for u := 0; u < radix; u++ {
+			j := u*m + k
+			sum := tmp[0]
+			for q := 1; q < radix; q++ {
+				sum += tmp[q] * p.twiddles[(q*j%n)*twStep]
+			}
+			dst[j] = sum
+		}
+	}
+}
+
+// ttLengths covers power of two, mixed-radix and Bluestein cases.
+var ttLengths = []int{1, 2, 3, 4, 5, 6, 7, 8, 12, 16, 30, 31, 37, 64, 97, 100, 128, 210, 256, 343, 1000, 1009, 1024}
+
+// Unit tests.
+
+func TestForwardDFT(t *testing.T) {
+	for _, n := range ttLengths {
+		x := ttInput(uint64(n), n)
+		have := Forward(x)
+		want := ttDFT(x, -1)
+		// float32 FFT error grows as O(eps*log(n)).
+		tol := 1e-6 * (1 + math.Log2(float64(n)))
+		if err := ttRelError(have, want); err > tol {
+			t.Errorf("n=%d: relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func TestInverseDFT(t *testing.T) {
+	for _, n := range ttLengths {
+		x := ttInput(uint64(n)+100, n)
+		have := Inverse(x)
+		want := ttDFT(x, +1)
+		for i := range want {
+			want[i] /= complex(float64(n), 0)
+		}
+		tol := 1e-6 * (1 + math.Log2(float64(n)))
+		if err := ttRelError(have, want); err > tol {
+			t.Errorf("n=%d: relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, n := range ttLengths {
+		x := ttInput(uint64(n)+200, n)
+		have := Inverse(Forward(x))
+		want := make([]complex128, n)
+		for i, v := range x {
+			want[i] = complex128(complex(v.Real(), v.Imag()))
+		}
+		tol := 2e-6 * (1 + math.Log2(float64(n)))
+		if err := ttRelError(have, want); err > tol {
+			t.Errorf("n=%d: relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func TestBuiltinEquivalence(t *testing.T) {
+	for _, n := range ttLengths {
+		x := ttInput(uint64(n)+300, n)
+		p := NewPlan(n)
+		pb := ttNewPlanBuiltin(p)
+
+		have := make([]xmath.Complex64, n)
+		want := make([]complex64, n)
+		p.Forward(have, x)
+		pb.forward(want, ttToBuiltin(x))
+		for i := range want {
+			if complex(have[i].Real(), have[i].Imag()) != want[i] {
+				t.Errorf("n=%d: forward [%d] mismatch;\nwant: %v\nhave: %v", n, i, want[i], have[i])
+			}
+		}
+
+		p.Inverse(have, x)
+		pb.inverse(want, ttToBuiltin(x))
+		for i := range want {
+			if complex(have[i].Real(), have[i].Imag()) != want[i] {
+				t.Errorf("n=%d: inverse [%d] mismatch;\nwant: %v\nhave: %v", n, i, want[i], have[i])
+			}
+		}
+	}
+}
+
+func TestPlanPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"zero length", func() { NewPlan(0) }},
+		{"short dst", func() { NewPlan(4).Forward(make([]xmath.Complex64, 3), make([]xmath.Complex64, 4)) }},
+		{"short src", func() { NewPlan(4).Inverse(make([]xmath.Complex64, 4), make([]xmath.Complex64, 3)) }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", tt.name)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// Performance tests.
+
+var ttBenchLengths = []int{64, 1000, 1009, 4096}
+
+func BenchmarkForwardBuiltin(b *testing.B) {
+	for _, n := range ttBenchLengths {
+		p := ttNewPlanBuiltin(NewPlan(n))
+		x := ttToBuiltin(ttInput(1, n))
+		dst := make([]complex64, n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.forward(dst, x)
+			}
+		})
+	}
+}
+
+func BenchmarkForward(b *testing.B) {
+	for _, n := range ttBenchLengths {
+		p := NewPlan(n)
+		x := ttInput(1, n)
+		dst := make([]xmath.Complex64, n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.Forward(dst, x)
+			}
+		})
+	}
+}
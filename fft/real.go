@@ -0,0 +1,170 @@
+package fft
+
+import (
+	"math"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// RealPlan holds precomputed data for real-input transforms of a fixed length.
+//
+// The spectrum of a real sequence of length n is Hermitian:
+// X[n-k] = conj(X[k]), so only n/2+1 first coefficients are stored.
+//
+// For even n, the sequence is packed into a complex sequence
+// of length n/2 (even samples become real parts, odd samples become
+// imaginary parts), which is transformed with a half-size complex FFT.
+// Odd n are transformed with a full-size complex FFT.
+type RealPlan struct {
+	n    int
+	plan *Plan
+
+	// twiddles[k] = exp(-2*pi*i*k/n) for k in [0, n/2], even n only.
+	twiddles []xmath.Complex64
+}
+
+// NewRealPlan returns a RealPlan for transforms of length n.
+// It panics if n is not positive.
+func NewRealPlan(n int) *RealPlan {
+	if n <= 0 {
+		panic("fft: non-positive length")
+	}
+	if n%2 != 0 {
+		return &RealPlan{n: n, plan: NewPlan(n)}
+	}
+	p := &RealPlan{
+		n:        n,
+		plan:     NewPlan(n / 2),
+		twiddles: make([]xmath.Complex64, n/2+1),
+	}
+	for k := range p.twiddles {
+		sin, cos := math.Sincos(-2 * math.Pi * float64(k) / float64(n))
+		p.twiddles[k] = xmath.NewComplex64(float32(cos), float32(sin))
+	}
+	return p
+}
+
+// Len returns the real sequence length.
+func (p *RealPlan) Len() int { return p.n }
+
+// SpectrumLen returns the number of stored spectrum coefficients, n/2+1.
+func (p *RealPlan) SpectrumLen() int { return p.n/2 + 1 }
+
+// Forward computes the first SpectrumLen() coefficients
+// of the forward transform of src into dst.
+func (p *RealPlan) Forward(dst []xmath.Complex64, src []float32) {
+	if len(src) != p.n || len(dst) != p.SpectrumLen() {
+		panic("fft: slice length mismatch")
+	}
+	p.forward(dst, src, p.newScratch())
+}
+
+// newScratch returns a scratch space for forward and inverse.
+func (p *RealPlan) newScratch() []xmath.Complex64 {
+	if p.twiddles == nil {
+		return make([]xmath.Complex64, 2*p.n)
+	}
+	return make([]xmath.Complex64, p.n)
+}
+
+func (p *RealPlan) forward(dst []xmath.Complex64, src []float32, scratch []xmath.Complex64) {
+	if p.twiddles == nil {
+		full, spectrum := scratch[:p.n], scratch[p.n:2*p.n]
+		for i, x := range src {
+			full[i] = xmath.NewComplex64(x, 0)
+		}
+		p.plan.forward(spectrum, full)
+		copy(dst, spectrum)
+		return
+	}
+
+	m := p.n / 2
+	packed, z := scratch[:m], scratch[m:2*m]
+	for j := range packed {
+		packed[j] = xmath.NewComplex64(src[2*j], src[2*j+1])
+	}
+	p.plan.forward(z, packed)
+
+	half := xmath.NewComplex64(0.5, 0)
+	minusHalfI := xmath.NewComplex64(0, -0.5)
+	for k := 0; k <= m; k++ {
+		zk := z[k%m]
+		zc := z[(m-k)%m].Conj()
+		// even = (Z[k] + conj(Z[m-k])) / 2 is the spectrum of even samples,
+		// odd = (Z[k] - conj(Z[m-k])) / 2i is the spectrum of odd samples.
+		even := zk.Add(zc).Mul(half)
+		odd := zk.Sub(zc).Mul(minusHalfI)
+		dst[k] = even.Add(odd.Mul(p.twiddles[k]))
+	}
+}
+
+// Inverse computes the real sequence dst from its first
+// SpectrumLen() spectrum coefficients src, including the 1/n scaling.
+//
+// Imaginary parts of src[0] (and src[n/2] for even n) are ignored,
+// as they are always zero for a spectrum of a real sequence.
+func (p *RealPlan) Inverse(dst []float32, src []xmath.Complex64) {
+	if len(dst) != p.n || len(src) != p.SpectrumLen() {
+		panic("fft: slice length mismatch")
+	}
+	p.inverse(dst, src, p.newScratch())
+}
+
+func (p *RealPlan) inverse(dst []float32, src, scratch []xmath.Complex64) {
+	if p.twiddles == nil {
+		full, res := scratch[:p.n], scratch[p.n:2*p.n]
+		full[0] = xmath.NewComplex64(src[0].Real(), 0)
+		for k := 1; k < len(src); k++ {
+			full[k] = src[k]
+			full[p.n-k] = src[k].Conj()
+		}
+		p.plan.inverse(res, full, full)
+		for i, x := range res {
+			dst[i] = x.Real()
+		}
+		return
+	}
+
+	m := p.n / 2
+	z, packed := scratch[:m], scratch[m:2*m]
+	half := xmath.NewComplex64(0.5, 0)
+	imagUnit := xmath.NewComplex64(0, 1)
+	for k := 0; k < m; k++ {
+		xk := src[k]
+		xc := src[m-k].Conj()
+		if k == 0 {
+			xk = xmath.NewComplex64(xk.Real(), 0)
+			xc = xmath.NewComplex64(src[m].Real(), 0)
+		}
+		even := xk.Add(xc).Mul(half)
+		odd := xk.Sub(xc).Mul(half).Mul(p.twiddles[k].Conj())
+		z[k] = even.Add(odd.Mul(imagUnit))
+	}
+	p.plan.inverse(packed, z, z)
+	for j, x := range packed {
+		dst[2*j] = x.Real()
+		dst[2*j+1] = x.Imag()
+	}
+}
+
+// RealFFT returns the first len(x)/2+1 coefficients
+// of the forward transform of real sequence x.
+func RealFFT(x []float32) []xmath.Complex64 {
+	if len(x) == 0 {
+		return nil
+	}
+	p := NewRealPlan(len(x))
+	dst := make([]xmath.Complex64, p.SpectrumLen())
+	p.Forward(dst, x)
+	return dst
+}
+
+// InverseRealFFT returns the real sequence of length n
+// from the first n/2+1 coefficients of its spectrum.
+func InverseRealFFT(spectrum []xmath.Complex64, n int) []float32 {
+	dst := make([]float32, n)
+	if n != 0 {
+		NewRealPlan(n).Inverse(dst, spectrum)
+	}
+	return dst
+}
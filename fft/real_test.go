@@ -0,0 +1,127 @@
+package fft
+
+import (
+	"math"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+func ttRealInput(seed uint64, n int) []float32 {
+	xs := ttInput(seed, (n+1)/2)
+	res := make([]float32, n)
+	for i := range res {
+		if i%2 == 0 {
+			res[i] = xs[i/2].Real()
+		} else {
+			res[i] = xs[i/2].Imag()
+		}
+	}
+	return res
+}
+
+var ttRealLengths = []int{1, 2, 3, 4, 5, 6, 8, 10, 15, 16, 62, 74, 97, 100, 128, 1000, 1009, 1024, 2018}
+
+func TestRealFFTComplexEquivalence(t *testing.T) {
+	for _, n := range ttRealLengths {
+		x := ttRealInput(uint64(n), n)
+		full := make([]xmath.Complex64, n)
+		for i, v := range x {
+			full[i] = xmath.NewComplex64(v, 0)
+		}
+		spectrum := Forward(full)
+
+		have := RealFFT(x)
+		if len(have) != n/2+1 {
+			t.Fatalf("n=%d: want %d coefficients, have %d", n, n/2+1, len(have))
+		}
+		want := make([]complex128, len(have))
+		for k := range want {
+			want[k] = complex128(complex(spectrum[k].Real(), spectrum[k].Imag()))
+		}
+		tol := 2e-6 * (1 + math.Log2(float64(n)))
+		if err := ttRelError(have, want); err > tol {
+			t.Errorf("n=%d: relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func TestRealFFTDFT(t *testing.T) {
+	for _, n := range ttRealLengths {
+		x := ttRealInput(uint64(n)+100, n)
+		full := make([]xmath.Complex64, n)
+		for i, v := range x {
+			full[i] = xmath.NewComplex64(v, 0)
+		}
+		want := ttDFT(full, -1)[:n/2+1]
+		tol := 2e-6 * (1 + math.Log2(float64(n)))
+		if err := ttRelError(RealFFT(x), want); err > tol {
+			t.Errorf("n=%d: relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func TestRealFFTRoundTrip(t *testing.T) {
+	for _, n := range ttRealLengths {
+		x := ttRealInput(uint64(n)+200, n)
+		have := InverseRealFFT(RealFFT(x), n)
+
+		var diff, norm float64
+		for i := range x {
+			d := float64(have[i]) - float64(x[i])
+			diff += d * d
+			norm += float64(x[i]) * float64(x[i])
+		}
+		// Round trip error bound: a few float32 epsilons
+		// per transform stage.
+		tol := 8e-7 * (1 + math.Log2(float64(n)))
+		if err := math.Sqrt(diff / norm); err > tol {
+			t.Errorf("n=%d: round trip relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func TestInverseRealFFT(t *testing.T) {
+	// Inverse must agree with the full complex inverse
+	// of the Hermitian-extended spectrum.
+	r := xrand.New(1)
+	for _, n := range ttRealLengths {
+		spectrum := make([]xmath.Complex64, n/2+1)
+		xrand.Fill(spectrum, func() xmath.Complex64 { return r.Disk(1) })
+		spectrum[0] = xmath.NewComplex64(spectrum[0].Real(), 0)
+		if n%2 == 0 {
+			spectrum[n/2] = xmath.NewComplex64(spectrum[n/2].Real(), 0)
+		}
+		full := make([]xmath.Complex64, n)
+		copy(full, spectrum)
+		for k := 1; k < len(spectrum); k++ {
+			full[n-k] = spectrum[k].Conj()
+		}
+
+		want := Inverse(full)
+		have := InverseRealFFT(spectrum, n)
+		var diff, norm float64
+		for i := range want {
+			d := float64(have[i]) - float64(want[i].Real())
+			diff += d * d
+			norm += float64(want[i].Real()) * float64(want[i].Real())
+			if im := math.Abs(float64(want[i].Imag())); im > 1e-5 {
+				t.Fatalf("n=%d: full inverse is not real: %v", n, want[i])
+			}
+		}
+		tol := 2e-6 * (1 + math.Log2(float64(n)))
+		if err := math.Sqrt(diff / norm); err > tol {
+			t.Errorf("n=%d: relative error %g exceeds %g", n, err, tol)
+		}
+	}
+}
+
+func BenchmarkRealFFT(b *testing.B) {
+	x := ttRealInput(1, 4096)
+	p := NewRealPlan(len(x))
+	dst := make([]xmath.Complex64, p.SpectrumLen())
+	for i := 0; i < b.N; i++ {
+		p.Forward(dst, x)
+	}
+}
@@ -0,0 +1,303 @@
+package fft
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// This file implements block-based streaming convolution
+// with a fixed FIR filter.
+//
+// Both OverlapAdd and OverlapSave produce the same output stream:
+// y[k] = sum h[j] * x[k-j], where x is the concatenation of all
+// processed blocks (and x[k] is zero for negative k).
+// OverlapSave is usually a bit faster, while OverlapAdd
+// can also Flush the filter tail at the end of the stream.
+
+// OverlapAdd is a streaming FIR filter that uses the overlap-add method.
+type OverlapAdd struct {
+	plan     *Plan
+	blockLen int
+	kernel   []xmath.Complex64
+
+	// tail holds the pending len(h)-1 outputs of the previous blocks.
+	tail []xmath.Complex64
+
+	buf      []xmath.Complex64
+	spectrum []xmath.Complex64
+}
+
+// NewOverlapAdd returns an overlap-add filter with impulse response h.
+// Every Process call consumes blockLen input samples.
+// If blockLen is not positive, it's selected automatically.
+func NewOverlapAdd(h []xmath.Complex64, blockLen int) *OverlapAdd {
+	plan, blockLen, kernel := newStreamKernel(h, blockLen)
+	return &OverlapAdd{
+		plan:     plan,
+		blockLen: blockLen,
+		kernel:   kernel,
+		tail:     make([]xmath.Complex64, len(h)-1),
+		buf:      make([]xmath.Complex64, plan.Len()),
+		spectrum: make([]xmath.Complex64, plan.Len()),
+	}
+}
+
+// BlockLen returns the number of samples consumed by Process.
+func (f *OverlapAdd) BlockLen() int { return f.blockLen }
+
+// Process filters the next block x and stores the result into dst.
+// Both dst and x must have BlockLen() elements.
+func (f *OverlapAdd) Process(dst, x []xmath.Complex64) {
+	if len(dst) != f.blockLen || len(x) != f.blockLen {
+		panic("fft: block length mismatch")
+	}
+	clear(f.buf)
+	copy(f.buf, x)
+	f.plan.forward(f.spectrum, f.buf)
+	for i := range f.spectrum {
+		f.spectrum[i].MulAssign(f.kernel[i])
+	}
+	// The spectrum is no longer needed, so it's used as a scratch space.
+	f.plan.inverse(f.buf, f.spectrum, f.spectrum)
+
+	// Block convolution has blockLen+len(h)-1 outputs:
+	// the first part is combined with the previous tail,
+	// the rest becomes the new tail.
+	out := f.buf[:f.blockLen+len(f.tail)]
+	for i, t := range f.tail {
+		out[i].AddAssign(t)
+	}
+	copy(dst, out[:f.blockLen])
+	copy(f.tail, out[f.blockLen:])
+}
+
+// Flush stores the remaining len(h)-1 outputs into dst
+// and resets the filter state.
+func (f *OverlapAdd) Flush(dst []xmath.Complex64) {
+	if len(dst) != len(f.tail) {
+		panic("fft: flush length mismatch")
+	}
+	copy(dst, f.tail)
+	clear(f.tail)
+}
+
+// OverlapSave is a streaming FIR filter that uses the overlap-save method.
+type OverlapSave struct {
+	plan     *Plan
+	blockLen int
+	kernel   []xmath.Complex64
+
+	// history holds the last len(h)-1 input samples.
+	history []xmath.Complex64
+
+	buf      []xmath.Complex64
+	spectrum []xmath.Complex64
+}
+
+// NewOverlapSave returns an overlap-save filter with impulse response h.
+// Every Process call consumes blockLen input samples.
+// If blockLen is not positive, it's selected automatically.
+func NewOverlapSave(h []xmath.Complex64, blockLen int) *OverlapSave {
+	plan, blockLen, kernel := newStreamKernel(h, blockLen)
+	return &OverlapSave{
+		plan:     plan,
+		blockLen: blockLen,
+		kernel:   kernel,
+		history:  make([]xmath.Complex64, len(h)-1),
+		buf:      make([]xmath.Complex64, plan.Len()),
+		spectrum: make([]xmath.Complex64, plan.Len()),
+	}
+}
+
+// BlockLen returns the number of samples consumed by Process.
+func (f *OverlapSave) BlockLen() int { return f.blockLen }
+
+// Process filters the next block x and stores the result into dst.
+// Both dst and x must have BlockLen() elements.
+func (f *OverlapSave) Process(dst, x []xmath.Complex64) {
+	if len(dst) != f.blockLen || len(x) != f.blockLen {
+		panic("fft: block length mismatch")
+	}
+	m := len(f.history)
+	clear(f.buf)
+	copy(f.buf, f.history)
+	copy(f.buf[m:], x)
+	f.plan.forward(f.spectrum, f.buf)
+	for i := range f.spectrum {
+		f.spectrum[i].MulAssign(f.kernel[i])
+	}
+	// The spectrum is no longer needed, so it's used as a scratch space.
+	f.plan.inverse(f.buf, f.spectrum, f.spectrum)
+
+	// The first m outputs are corrupted by the circular wrap-around,
+	// they are discarded.
+	copy(dst, f.buf[m:m+f.blockLen])
+	if m > f.blockLen {
+		copy(f.history, f.history[f.blockLen:])
+		copy(f.history[m-f.blockLen:], x)
+	} else {
+		copy(f.history, x[f.blockLen-m:])
+	}
+}
+
+// Reset clears the filter state.
+func (f *OverlapSave) Reset() {
+	clear(f.history)
+}
+
+// newStreamKernel returns a plan, a block length and
+// a transformed impulse response for a streaming filter.
+func newStreamKernel(h []xmath.Complex64, blockLen int) (*Plan, int, []xmath.Complex64) {
+	blockLen = streamBlockLen(len(h), blockLen)
+	plan := NewPlan(fastLen(blockLen + len(h) - 1))
+	return plan, blockLen, plan.transformPadded(h)
+}
+
+// newRealStreamKernel is like newStreamKernel, but for real filters.
+// The kernel holds SpectrumLen() coefficients of the plan.
+func newRealStreamKernel(h []float32, blockLen int) (*RealPlan, int, []xmath.Complex64) {
+	blockLen = streamBlockLen(len(h), blockLen)
+	plan := NewRealPlan(fastLen(blockLen + len(h) - 1))
+	padded := make([]float32, plan.Len())
+	copy(padded, h)
+	kernel := make([]xmath.Complex64, plan.SpectrumLen())
+	plan.Forward(kernel, padded)
+	return plan, blockLen, kernel
+}
+
+// streamBlockLen returns the block length for a filter with
+// impulse response of hLen elements.
+func streamBlockLen(hLen, blockLen int) int {
+	if hLen == 0 {
+		panic("fft: empty impulse response")
+	}
+	if blockLen <= 0 {
+		// Blocks that are several times longer than the filter
+		// amortize the transform cost well.
+		blockLen = max(fastLen(4*hLen)-hLen+1, 1)
+	}
+	return blockLen
+}
+
+// OverlapAddReal is like OverlapAdd, but operates on real sequences.
+// Blocks are transformed with RealPlan, which is about twice as fast
+// as complex transforms of the same length.
+type OverlapAddReal struct {
+	plan     *RealPlan
+	blockLen int
+	kernel   []xmath.Complex64
+	tail     []float32
+
+	buf      []float32
+	spectrum []xmath.Complex64
+	scratch  []xmath.Complex64
+}
+
+// NewOverlapAddReal returns an overlap-add filter with impulse response h.
+func NewOverlapAddReal(h []float32, blockLen int) *OverlapAddReal {
+	plan, blockLen, kernel := newRealStreamKernel(h, blockLen)
+	return &OverlapAddReal{
+		plan:     plan,
+		blockLen: blockLen,
+		kernel:   kernel,
+		tail:     make([]float32, len(h)-1),
+		buf:      make([]float32, plan.Len()),
+		spectrum: make([]xmath.Complex64, plan.SpectrumLen()),
+		scratch:  plan.newScratch(),
+	}
+}
+
+// BlockLen returns the number of samples consumed by Process.
+func (f *OverlapAddReal) BlockLen() int { return f.blockLen }
+
+// Process filters the next block x and stores the result into dst.
+// Both dst and x must have BlockLen() elements.
+func (f *OverlapAddReal) Process(dst, x []float32) {
+	if len(dst) != f.blockLen || len(x) != f.blockLen {
+		panic("fft: block length mismatch")
+	}
+	clear(f.buf)
+	copy(f.buf, x)
+	f.plan.forward(f.spectrum, f.buf, f.scratch)
+	for i := range f.spectrum {
+		f.spectrum[i].MulAssign(f.kernel[i])
+	}
+	f.plan.inverse(f.buf, f.spectrum, f.scratch)
+
+	// See OverlapAdd.Process.
+	out := f.buf[:f.blockLen+len(f.tail)]
+	for i, t := range f.tail {
+		out[i] += t
+	}
+	copy(dst, out[:f.blockLen])
+	copy(f.tail, out[f.blockLen:])
+}
+
+// Flush stores the remaining len(h)-1 outputs into dst
+// and resets the filter state.
+func (f *OverlapAddReal) Flush(dst []float32) {
+	if len(dst) != len(f.tail) {
+		panic("fft: flush length mismatch")
+	}
+	copy(dst, f.tail)
+	clear(f.tail)
+}
+
+// OverlapSaveReal is like OverlapSave, but operates on real sequences.
+type OverlapSaveReal struct {
+	plan     *RealPlan
+	blockLen int
+	kernel   []xmath.Complex64
+	history  []float32
+
+	buf      []float32
+	spectrum []xmath.Complex64
+	scratch  []xmath.Complex64
+}
+
+// NewOverlapSaveReal returns an overlap-save filter with impulse response h.
+func NewOverlapSaveReal(h []float32, blockLen int) *OverlapSaveReal {
+	plan, blockLen, kernel := newRealStreamKernel(h, blockLen)
+	return &OverlapSaveReal{
+		plan:     plan,
+		blockLen: blockLen,
+		kernel:   kernel,
+		history:  make([]float32, len(h)-1),
+		buf:      make([]float32, plan.Len()),
+		spectrum: make([]xmath.Complex64, plan.SpectrumLen()),
+		scratch:  plan.newScratch(),
+	}
+}
+
+// BlockLen returns the number of samples consumed by Process.
+func (f *OverlapSaveReal) BlockLen() int { return f.blockLen }
+
+// Process filters the next block x and stores the result into dst.
+// Both dst and x must have BlockLen() elements.
+func (f *OverlapSaveReal) Process(dst, x []float32) {
+	if len(dst) != f.blockLen || len(x) != f.blockLen {
+		panic("fft: block length mismatch")
+	}
+	m := len(f.history)
+	clear(f.buf)
+	copy(f.buf, f.history)
+	copy(f.buf[m:], x)
+	f.plan.forward(f.spectrum, f.buf, f.scratch)
+	for i := range f.spectrum {
+		f.spectrum[i].MulAssign(f.kernel[i])
+	}
+	f.plan.inverse(f.buf, f.spectrum, f.scratch)
+
+	// See OverlapSave.Process.
+	copy(dst, f.buf[m:m+f.blockLen])
+	if m > f.blockLen {
+		copy(f.history, f.history[f.blockLen:])
+		copy(f.history[m-f.blockLen:], x)
+	} else {
+		copy(f.history, x[f.blockLen-m:])
+	}
+}
+
+// Reset clears the filter state.
+func (f *OverlapSaveReal) Reset() {
+	clear(f.history)
+}
@@ -0,0 +1,220 @@
+package xmath
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrOverflow is returned by checked integer operations
+// when the result doesn't fit into the result type.
+var ErrOverflow = errors.New("xmath: integer overflow")
+
+// GaussInt is a Gaussian integer: a complex number
+// with int64 real and imaginary parts.
+//
+// Like Go integer operations, Add, Sub, Mul and Norm wrap around
+// on overflow. Operations with "Checked" suffix return
+// ErrOverflow instead.
+type GaussInt struct {
+	r int64
+	i int64
+}
+
+// NewGaussInt returns r + i*i Gaussian integer.
+func NewGaussInt(r, i int64) GaussInt {
+	return GaussInt{r: r, i: i}
+}
+
+// Real returns Gaussian integer real part.
+func (c GaussInt) Real() int64 { return c.r }
+
+// Imag returns Gaussian integer imaginary part.
+func (c GaussInt) Imag() int64 { return c.i }
+
+// Conj returns the conjugate of c.
+func (c GaussInt) Conj() GaussInt {
+	return GaussInt{r: c.r, i: -c.i}
+}
+
+// IsZero returns true if both c.Real() and c.Imag() return 0.
+func (c GaussInt) IsZero() bool {
+	return c == GaussInt{}
+}
+
+// Eq is "==" operation.
+func (c GaussInt) Eq(x GaussInt) bool {
+	return c == x
+}
+
+// Add is "+" operation.
+func (c GaussInt) Add(x GaussInt) GaussInt {
+	return GaussInt{r: c.r + x.r, i: c.i + x.i}
+}
+
+// Sub is "-" operation.
+func (c GaussInt) Sub(x GaussInt) GaussInt {
+	return GaussInt{r: c.r - x.r, i: c.i - x.i}
+}
+
+// Mul is "*" operation.
+func (c GaussInt) Mul(x GaussInt) GaussInt {
+	return GaussInt{
+		r: c.r*x.r - c.i*x.i,
+		i: c.r*x.i + c.i*x.r,
+	}
+}
+
+// Norm returns c.Real()^2 + c.Imag()^2.
+func (c GaussInt) Norm() int64 {
+	return c.r*c.r + c.i*c.i
+}
+
+// AddChecked is like Add, but returns ErrOverflow on overflow.
+func (c GaussInt) AddChecked(x GaussInt) (GaussInt, error) {
+	r, ok1 := addChecked(c.r, x.r)
+	i, ok2 := addChecked(c.i, x.i)
+	if !ok1 || !ok2 {
+		return GaussInt{}, ErrOverflow
+	}
+	return GaussInt{r: r, i: i}, nil
+}
+
+// SubChecked is like Sub, but returns ErrOverflow on overflow.
+func (c GaussInt) SubChecked(x GaussInt) (GaussInt, error) {
+	r, ok1 := subChecked(c.r, x.r)
+	i, ok2 := subChecked(c.i, x.i)
+	if !ok1 || !ok2 {
+		return GaussInt{}, ErrOverflow
+	}
+	return GaussInt{r: r, i: i}, nil
+}
+
+// MulChecked is like Mul, but returns ErrOverflow on overflow.
+// Only the final parts have to fit into int64,
+// intermediate products are computed with 128-bit precision.
+func (c GaussInt) MulChecked(x GaussInt) (GaussInt, error) {
+	r, ok1 := mul128(c.r, x.r).add(mul128(c.i, x.i).neg()).int64()
+	i, ok2 := mul128(c.r, x.i).add(mul128(c.i, x.r)).int64()
+	if !ok1 || !ok2 {
+		return GaussInt{}, ErrOverflow
+	}
+	return GaussInt{r: r, i: i}, nil
+}
+
+// NormChecked is like Norm, but returns ErrOverflow on overflow.
+func (c GaussInt) NormChecked() (int64, error) {
+	n, ok := mul128(c.r, c.r).add(mul128(c.i, c.i)).int64()
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return n, nil
+}
+
+// DivMod returns the quotient and the remainder of c/x:
+//
+//	c = q*x + r, r.Norm() <= x.Norm()/2
+//
+// See BigGaussInt.DivMod for the rounding rules.
+// It returns ErrOverflow if the quotient or the remainder
+// doesn't fit into GaussInt.
+// It panics if x is zero.
+func (c GaussInt) DivMod(x GaussInt) (q, r GaussInt, err error) {
+	var bq, br BigGaussInt
+	bq.DivMod(new(BigGaussInt).SetGaussInt(c), new(BigGaussInt).SetGaussInt(x), &br)
+	if q, err = bq.GaussInt(); err != nil {
+		return GaussInt{}, GaussInt{}, err
+	}
+	if r, err = br.GaussInt(); err != nil {
+		return GaussInt{}, GaussInt{}, err
+	}
+	return q, r, nil
+}
+
+// GCD returns the normalized greatest common divisor of a and b.
+// It returns ErrOverflow if the normalized result doesn't fit into GaussInt.
+func GCD(a, b GaussInt) (GaussInt, error) {
+	var gcd BigGaussInt
+	gcd.GCD(new(BigGaussInt).SetGaussInt(a), new(BigGaussInt).SetGaussInt(b))
+	return gcd.GaussInt()
+}
+
+// IsPrime reports whether c is a Gaussian prime.
+// See BigGaussInt.IsPrime for details.
+func (c GaussInt) IsPrime() bool {
+	return new(BigGaussInt).SetGaussInt(c).IsPrime()
+}
+
+// Factor returns the factorization of c:
+//
+//	c = unit * primes[0] * primes[1] * ...
+//
+// See BigGaussInt.Factor for details.
+// It returns ErrOverflow if a normalized prime doesn't fit into GaussInt.
+// It panics if c is zero.
+func (c GaussInt) Factor() (unit GaussInt, primes []GaussInt, err error) {
+	bigUnit, bigPrimes := new(BigGaussInt).SetGaussInt(c).Factor()
+	unit, _ = bigUnit.GaussInt()
+	primes = make([]GaussInt, len(bigPrimes))
+	for i, p := range bigPrimes {
+		if primes[i], err = p.GaussInt(); err != nil {
+			return GaussInt{}, nil, err
+		}
+	}
+	return unit, primes, nil
+}
+
+func addChecked(x, y int64) (int64, bool) {
+	s := x + y
+	// Overflow happens if x and y have the same sign
+	// and s has a different one.
+	return s, (x >= 0) != (y >= 0) || (s >= 0) == (x >= 0)
+}
+
+func subChecked(x, y int64) (int64, bool) {
+	d := x - y
+	return d, (x >= 0) == (y >= 0) || (d >= 0) == (x >= 0)
+}
+
+// int128 is a signed 128-bit integer.
+// Sums of two int64 products fit into it, except for
+// MinInt64*MinInt64 + MinInt64*MinInt64 = 2^127, which wraps
+// to -2^127. Neither of them fits into int64, so overflow
+// checks of such sums are still correct.
+type int128 struct {
+	hi int64
+	lo uint64
+}
+
+// mul128 returns the 128-bit product of x and y.
+func mul128(x, y int64) int128 {
+	h, l := bits.Mul64(uint64(x), uint64(y))
+	// Signed correction of the unsigned product.
+	hi := int64(h)
+	if x < 0 {
+		hi -= y
+	}
+	if y < 0 {
+		hi -= x
+	}
+	return int128{hi: hi, lo: l}
+}
+
+func (x int128) add(y int128) int128 {
+	lo, carry := bits.Add64(x.lo, y.lo, 0)
+	return int128{hi: x.hi + y.hi + int64(carry), lo: lo}
+}
+
+func (x int128) neg() int128 {
+	return int128{hi: ^x.hi, lo: ^x.lo}.add(int128{lo: 1})
+}
+
+// int64 converts x to int64 and reports whether the conversion is exact.
+func (x int128) int64() (int64, bool) {
+	return int64(x.lo), x.hi == int64(x.lo)>>63
+}
+
+// sar returns x arithmetically shifted right by n < 64 bits,
+// the result must fit into int64.
+func (x int128) sar(n uint) int64 {
+	return int64(x.lo>>n | uint64(x.hi)<<(64-n))
+}
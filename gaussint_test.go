@@ -0,0 +1,327 @@
+package xmath
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttGaussInts returns edge case and random Gaussian integers.
+func ttGaussInts() []GaussInt {
+	parts := []int64{
+		0, 1, -1, 2, -3, 1 << 31, -(1 << 31), 1<<32 + 1, 3037000499, -3037000500,
+		math.MaxInt64, math.MinInt64, math.MaxInt64 / 2, math.MinInt64 / 2,
+	}
+	var values []GaussInt
+	for _, r := range parts {
+		for _, i := range parts {
+			values = append(values, NewGaussInt(r, i))
+		}
+	}
+	rng := rand.New(rand.NewPCG(41, 0))
+	for i := 0; i < 100; i++ {
+		shift := rng.IntN(64)
+		values = append(values, NewGaussInt(rng.Int64()>>shift, -rng.Int64()>>shift))
+	}
+	return values
+}
+
+func ttBigGauss(c GaussInt) *BigGaussInt {
+	return new(BigGaussInt).SetGaussInt(c)
+}
+
+// ttIsPrimeInt reports whether n is a prime using trial division.
+func ttIsPrimeInt(n int64) bool {
+	if n < 2 {
+		return false
+	}
+	for d := int64(2); d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Unit tests.
+
+func TestGaussIntArith(t *testing.T) {
+	values := ttGaussInts()
+	for _, x := range values {
+		for _, y := range values {
+			tests := []struct {
+				name    string
+				op      func(x, y GaussInt) GaussInt
+				checked func(x, y GaussInt) (GaussInt, error)
+				bigOp   func(z, x, y *BigGaussInt) *BigGaussInt
+			}{
+				{"+", GaussInt.Add, GaussInt.AddChecked, (*BigGaussInt).Add},
+				{"-", GaussInt.Sub, GaussInt.SubChecked, (*BigGaussInt).Sub},
+				{"*", GaussInt.Mul, GaussInt.MulChecked, (*BigGaussInt).Mul},
+			}
+			for _, tt := range tests {
+				want := tt.bigOp(new(BigGaussInt), ttBigGauss(x), ttBigGauss(y))
+				// Wrapped result is the exact result modulo 2^64.
+				wrapped := tt.op(x, y)
+				if wrapped.r != int64(want.re.Uint64()) && wrapped.r != -int64(new(big.Int).Neg(&want.re).Uint64()) {
+					t.Errorf("`%v%s%v` real part: have %d, want %v mod 2^64", x, tt.name, y, wrapped.r, &want.re)
+				}
+
+				have, err := tt.checked(x, y)
+				wantChecked, wantErr := want.GaussInt()
+				if err != wantErr || have != wantChecked {
+					t.Errorf("`%v%s%v` checked failed;\nwant: %v, %v\nhave: %v, %v",
+						x, tt.name, y, wantChecked, wantErr, have, err)
+				}
+				if err == nil && have != wrapped {
+					t.Errorf("`%v%s%v`: checked %v != wrapped %v", x, tt.name, y, have, wrapped)
+				}
+			}
+		}
+	}
+}
+
+func TestGaussIntNorm(t *testing.T) {
+	for _, x := range ttGaussInts() {
+		want := ttBigGauss(x).Norm()
+		have, err := x.NormChecked()
+		if want.IsInt64() != (err == nil) || (err == nil && have != want.Int64()) {
+			t.Errorf("norm(%v) failed;\nwant: %v\nhave: %v, %v", x, want, have, err)
+		}
+		if err == nil && x.Norm() != have {
+			t.Errorf("norm(%v): checked %d != wrapped %d", x, have, x.Norm())
+		}
+	}
+}
+
+func TestGaussIntInt128Boundary(t *testing.T) {
+	// Sums of products that are equal to 2^127 overflow int128.
+	const m = math.MinInt64
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"(m+mi)*(m+mi)", func() error { _, err := NewGaussInt(m, m).MulChecked(NewGaussInt(m, m)); return err }},
+		{"norm(m+mi)", func() error { _, err := NewGaussInt(m, m).NormChecked(); return err }},
+	}
+	for _, tt := range tests {
+		if err := tt.fn(); err != ErrOverflow {
+			t.Errorf("%s: want ErrOverflow, have %v", tt.name, err)
+		}
+	}
+}
+
+func TestGaussIntDivMod(t *testing.T) {
+	values := ttGaussInts()
+	for _, x := range values {
+		for _, y := range values {
+			if y.IsZero() {
+				continue
+			}
+			q, r, err := x.DivMod(y)
+			var bq, br BigGaussInt
+			bq.DivMod(ttBigGauss(x), ttBigGauss(y), &br)
+
+			// x = q*y + r.
+			back := new(BigGaussInt).Mul(&bq, ttBigGauss(y))
+			back.Add(back, &br)
+			if !back.Eq(ttBigGauss(x)) {
+				t.Fatalf("%v/%v: q*y + r = %v", x, y, back)
+			}
+			// 2*N(r) <= N(y).
+			n2 := new(big.Int).Lsh(br.Norm(), 1)
+			if n2.Cmp(ttBigGauss(y).Norm()) > 0 {
+				t.Errorf("%v/%v: remainder %v is too big", x, y, &br)
+			}
+
+			wantQ, wantErr := bq.GaussInt()
+			wantR, errR := br.GaussInt()
+			if wantErr == nil {
+				wantErr = errR
+			}
+			if err != wantErr || (err == nil && (q != wantQ || r != wantR)) {
+				t.Errorf("%v/%v failed;\nwant: %v, %v, %v\nhave: %v, %v, %v",
+					x, y, wantQ, wantR, wantErr, q, r, err)
+			}
+		}
+	}
+
+	q, r, err := NewGaussInt(5, 3).DivMod(NewGaussInt(1, 1))
+	if want := NewGaussInt(4, -1); q != want || !r.IsZero() || err != nil {
+		t.Errorf("(5+3i)/(1+i): want %v, 0, have %v, %v, %v", want, q, r, err)
+	}
+
+	// The quotient is 1, but the remainder is 2^63*i.
+	_, _, err = NewGaussInt(math.MinInt64, 0).DivMod(NewGaussInt(math.MinInt64, math.MinInt64))
+	if err != ErrOverflow {
+		t.Errorf("MinInt64/(MinInt64+MinInt64*i): want ErrOverflow, have %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("division by zero: expected a panic")
+		}
+	}()
+	NewGaussInt(1, 1).DivMod(GaussInt{})
+}
+
+func TestGaussIntGCD(t *testing.T) {
+	tests := []struct {
+		a, b GaussInt
+		want GaussInt
+	}{
+		{NewGaussInt(5, 0), NewGaussInt(3, 1), NewGaussInt(1, 2)},
+		{NewGaussInt(0, 0), NewGaussInt(0, 0), NewGaussInt(0, 0)},
+		{NewGaussInt(0, 0), NewGaussInt(0, -7), NewGaussInt(7, 0)},
+		{NewGaussInt(2, 0), NewGaussInt(1, 1), NewGaussInt(1, 1)},
+		{NewGaussInt(4, 0), NewGaussInt(6, 0), NewGaussInt(2, 0)},
+		{NewGaussInt(11, 3), NewGaussInt(1, 8), NewGaussInt(2, 1)},
+		{NewGaussInt(13, 0), NewGaussInt(7, 0), NewGaussInt(1, 0)},
+	}
+	for _, test := range tests {
+		have, err := GCD(test.a, test.b)
+		if err != nil || have != test.want {
+			t.Errorf("gcd(%v, %v):\nwant: %v\nhave: %v, %v", test.a, test.b, test.want, have, err)
+		}
+	}
+
+	// The GCD divides both arguments.
+	values := ttGaussInts()
+	for i, a := range values {
+		b := values[(i*7+3)%len(values)]
+		g, err := GCD(a, b)
+		if err != nil || (g.IsZero() && !(a.IsZero() && b.IsZero())) {
+			t.Errorf("gcd(%v, %v): have %v, %v", a, b, g, err)
+			continue
+		}
+		if g.IsZero() {
+			continue
+		}
+		for _, x := range []GaussInt{a, b} {
+			if _, r, _ := x.DivMod(g); !r.IsZero() {
+				t.Errorf("gcd(%v, %v) = %v doesn't divide %v", a, b, g, x)
+			}
+		}
+	}
+}
+
+func TestGaussIntIsPrime(t *testing.T) {
+	for a := int64(-20); a <= 20; a++ {
+		for b := int64(-20); b <= 20; b++ {
+			x := NewGaussInt(a, b)
+			var want bool
+			switch {
+			case a == 0:
+				want = ttIsPrimeInt(abs64(b)) && abs64(b)%4 == 3
+			case b == 0:
+				want = ttIsPrimeInt(abs64(a)) && abs64(a)%4 == 3
+			default:
+				want = ttIsPrimeInt(a*a + b*b)
+			}
+			if have := x.IsPrime(); have != want {
+				t.Errorf("isPrime(%v):\nwant: %v\nhave: %v", x, want, have)
+			}
+		}
+	}
+}
+
+func TestGaussIntFactor(t *testing.T) {
+	values := []GaussInt{
+		NewGaussInt(1, 0), NewGaussInt(0, -1), NewGaussInt(2, 0), NewGaussInt(-12, 0),
+		NewGaussInt(5, 3), NewGaussInt(360, 0), NewGaussInt(0, 1<<40),
+		NewGaussInt(123456789, 987654321),
+		NewGaussInt(math.MaxInt64, math.MaxInt64),
+		NewGaussInt(math.MinInt64, 0),
+	}
+	rng := rand.New(rand.NewPCG(41, 1))
+	for i := 0; i < 50; i++ {
+		values = append(values, NewGaussInt(rng.Int64N(1<<20)-1<<19, rng.Int64N(1<<20)-1<<19))
+	}
+
+	for _, x := range values {
+		if x.IsZero() {
+			continue
+		}
+		unit, primes, err := x.Factor()
+		if err != nil {
+			t.Errorf("factor(%v): %v", x, err)
+			continue
+		}
+		switch unit {
+		case NewGaussInt(1, 0), NewGaussInt(0, 1), NewGaussInt(-1, 0), NewGaussInt(0, -1):
+		default:
+			t.Errorf("factor(%v): %v is not a unit", x, unit)
+		}
+		prod := ttBigGauss(unit)
+		for i, p := range primes {
+			if !p.IsPrime() || p.r <= 0 || p.i < 0 {
+				t.Errorf("factor(%v): %v is not a normalized prime", x, p)
+			}
+			if i > 0 && ttBigGauss(primes[i-1]).Norm().Cmp(ttBigGauss(p).Norm()) > 0 {
+				t.Errorf("factor(%v): primes are not sorted: %v", x, primes)
+			}
+			prod.Mul(prod, ttBigGauss(p))
+		}
+		if !prod.Eq(ttBigGauss(x)) {
+			t.Errorf("factor(%v): product of %v * %v is %v", x, unit, primes, prod)
+		}
+	}
+
+	unit, primes, _ := NewGaussInt(-12, 0).Factor()
+	want := []GaussInt{NewGaussInt(1, 1), NewGaussInt(1, 1), NewGaussInt(1, 1), NewGaussInt(1, 1), NewGaussInt(3, 0)}
+	if unit != NewGaussInt(1, 0) || len(primes) != len(want) {
+		t.Fatalf("factor(-12): have %v * %v", unit, primes)
+	}
+	for i := range want {
+		if primes[i] != want[i] {
+			t.Errorf("factor(-12): want %v, have %v", want, primes)
+			break
+		}
+	}
+}
+
+func TestBigGaussInt(t *testing.T) {
+	x := NewBigGaussInt(3, -4)
+	if have := x.String(); have != "(3-4i)" {
+		t.Errorf("String: have %s", have)
+	}
+	if have := x.Norm(); have.Int64() != 25 {
+		t.Errorf("Norm: have %v", have)
+	}
+
+	// Aliasing of the receiver and the operands.
+	y := NewBigGaussInt(1, 2)
+	y.Mul(y, y)
+	if want := NewBigGaussInt(-3, 4); !y.Eq(want) {
+		t.Errorf("y*y: want %v, have %v", want, y)
+	}
+	q, r := x.DivMod(x, NewBigGaussInt(1, 1), y)
+	if !q.Eq(x) || !r.Eq(y) {
+		t.Errorf("DivMod results are not stored into the receivers")
+	}
+
+	huge := new(BigGaussInt).SetParts(new(big.Int).Lsh(big.NewInt(1), 63), big.NewInt(0))
+	if _, err := huge.GaussInt(); err != ErrOverflow {
+		t.Errorf("2^63: want ErrOverflow, have %v", err)
+	}
+
+	// 2^89-1 is a Mersenne prime, 3 mod 4.
+	p := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 89), big.NewInt(1))
+	if !new(BigGaussInt).SetParts(p, new(big.Int)).IsPrime() {
+		t.Errorf("2^89-1 is not prime")
+	}
+	unit, primes := new(BigGaussInt).SetParts(new(big.Int).Mul(p, big.NewInt(5)), new(big.Int)).Factor()
+	if len(primes) != 3 || !unit.Eq(NewBigGaussInt(0, -1)) {
+		t.Errorf("factor(5*(2^89-1)): have %v * %v", unit, primes)
+	}
+}
+
+func abs64(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
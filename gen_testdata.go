@@ -0,0 +1,100 @@
+//go:build ignore
+// +build ignore
+
+// This program generates testdata_xrand.go.
+// Run it with "go generate".
+//
+// Values in testdata.go are kept as they are,
+// benchmark results are measured on them.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"strconv"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+// seed is fixed to make the output reproducible.
+const seed = 2017
+
+func main() {
+	r := xrand.New(seed)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by gen_testdata.go; DO NOT EDIT.
+
+package xmath
+
+import "math"
+
+// ttXrandValues are generated counterparts of ttValues,
+// including special values that ttValues lack.
+var ttXrandValues = []ttValueSet{
+`)
+	box := func(maxRe, maxIm float32) func() xmath.Complex64 {
+		return func() xmath.Complex64 {
+			return r.Box(xmath.NewComplex64(0, 0), xmath.NewComplex64(maxRe, maxIm))
+		}
+	}
+	disk := func(radius float32) func() xmath.Complex64 {
+		return func() xmath.Complex64 { return r.Disk(radius) }
+	}
+	logUniform := func(minAbs, maxAbs float32) func() xmath.Complex64 {
+		return func() xmath.Complex64 { return r.LogUniform(minAbs, maxAbs) }
+	}
+	signedBox := func(maxAbs float32) func() xmath.Complex64 {
+		return func() xmath.Complex64 {
+			return r.Box(xmath.NewComplex64(-maxAbs, -maxAbs), xmath.NewComplex64(maxAbs, maxAbs))
+		}
+	}
+	writeValues(&buf, 100, box(1e7, 1e7), box(1e7, 1e7))
+	writeValues(&buf, 100, box(1e5, 1e3), box(1e4, 1e7))
+	writeValues(&buf, 100, box(1e4, 1e4), box(1e4, 1e4))
+	writeValues(&buf, 40, signedBox(4.3e9), signedBox(4.3e9))
+	writeValues(&buf, 50, disk(1e3), disk(1e3))
+	writeValues(&buf, 50, logUniform(1e-30, 1e30), logUniform(1e-30, 1e30))
+	writeValues(&buf, 50, r.Bits, r.Bits)
+	writeValues(&buf, 50, r.Special, r.Special)
+	writeValues(&buf, 50, r.Special, logUniform(1e-3, 1e3))
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format output: %v", err)
+	}
+	if err := os.WriteFile("testdata_xrand.go", src, 0644); err != nil {
+		log.Fatalf("write output: %v", err)
+	}
+}
+
+func writeValues(buf *bytes.Buffer, n int, gen1, gen2 func() xmath.Complex64) {
+	// Values that have no constant representation
+	// are written as expressions.
+	formatPart := func(x float32) string {
+		switch {
+		case x != x:
+			return fmt.Sprintf("math.Float32frombits(%#08x)", math.Float32bits(x))
+		case math.IsInf(float64(x), +1):
+			return "float32(math.Inf(+1))"
+		case math.IsInf(float64(x), -1):
+			return "float32(math.Inf(-1))"
+		case x == 0 && math.Signbit(float64(x)):
+			return "float32(math.Copysign(0, -1))"
+		}
+		return strconv.FormatFloat(float64(x), 'g', -1, 32)
+	}
+	for i := 0; i < n; i++ {
+		x, y := gen1(), gen2()
+		fmt.Fprintf(buf, "{%s, %s, %s, %s},\n",
+			formatPart(x.Real()), formatPart(x.Imag()),
+			formatPart(y.Real()), formatPart(y.Imag()))
+	}
+}
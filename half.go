@@ -0,0 +1,227 @@
+package xmath
+
+// Complex32 is a storage type for complex numbers with
+// IEEE 754 binary16 (half precision) parts.
+//
+// Arithmetic is performed by widening to Complex64
+// and rounding the result back to Complex32.
+// Like Complex64, it has value semantics.
+type Complex32 struct {
+	r uint16
+	i uint16
+}
+
+// ComplexBF16 is a storage type for complex numbers with
+// bfloat16 parts: float32 values with 8 bits of precision.
+//
+// See Complex32 for the arithmetic rules.
+type ComplexBF16 struct {
+	r uint16
+	i uint16
+}
+
+// Complex32FromBits returns a Complex32 with r and i
+// binary16 bit patterns.
+func Complex32FromBits(r, i uint16) Complex32 {
+	return Complex32{r: r, i: i}
+}
+
+// ComplexBF16FromBits returns a ComplexBF16 with r and i
+// bfloat16 bit patterns.
+func ComplexBF16FromBits(r, i uint16) ComplexBF16 {
+	return ComplexBF16{r: r, i: i}
+}
+
+// Complex32 converts c to Complex32.
+// Every part is rounded to the nearest binary16 value (ties to even),
+// values that are too big become infinities. NaNs stay NaNs.
+func (c Complex64) Complex32() Complex32 {
+	return Complex32{r: float32toHalf(c.r), i: float32toHalf(c.i)}
+}
+
+// ComplexBF16 converts c to ComplexBF16.
+// See Complex64.Complex32 for rounding rules.
+func (c Complex64) ComplexBF16() ComplexBF16 {
+	return ComplexBF16{r: float32toBF16(c.r), i: float32toBF16(c.i)}
+}
+
+// Complex64 converts c to Complex64.
+// The conversion is exact.
+func (c Complex32) Complex64() Complex64 {
+	return Complex64{r: halfToFloat32(c.r), i: halfToFloat32(c.i)}
+}
+
+// Complex64 converts c to Complex64.
+// The conversion is exact.
+func (c ComplexBF16) Complex64() Complex64 {
+	return Complex64{r: bf16ToFloat32(c.r), i: bf16ToFloat32(c.i)}
+}
+
+// Bits returns binary16 bit patterns of c parts.
+func (c Complex32) Bits() (r, i uint16) { return c.r, c.i }
+
+// Bits returns bfloat16 bit patterns of c parts.
+func (c ComplexBF16) Bits() (r, i uint16) { return c.r, c.i }
+
+// Real returns complex number real part.
+func (c Complex32) Real() float32 { return halfToFloat32(c.r) }
+
+// Imag returns complex number imaginary part.
+func (c Complex32) Imag() float32 { return halfToFloat32(c.i) }
+
+// Real returns complex number real part.
+func (c ComplexBF16) Real() float32 { return bf16ToFloat32(c.r) }
+
+// Imag returns complex number imaginary part.
+func (c ComplexBF16) Imag() float32 { return bf16ToFloat32(c.i) }
+
+// Conj returns the complex conjugate of c.
+func (c Complex32) Conj() Complex32 {
+	return Complex32{r: c.r, i: c.i ^ 0x8000}
+}
+
+// Conj returns the complex conjugate of c.
+func (c ComplexBF16) Conj() ComplexBF16 {
+	return ComplexBF16{r: c.r, i: c.i ^ 0x8000}
+}
+
+// IsZero returns true if both c.Real() and c.Imag() return 0.
+func (c Complex32) IsZero() bool {
+	return c.r&0x7fff == 0 && c.i&0x7fff == 0
+}
+
+// IsZero returns true if both c.Real() and c.Imag() return 0.
+func (c ComplexBF16) IsZero() bool {
+	return c.r&0x7fff == 0 && c.i&0x7fff == 0
+}
+
+// Eq is "==" operation.
+// Like for other float types, +0 is equal to -0 and NaN
+// is not equal to anything.
+func (c Complex32) Eq(x Complex32) bool {
+	return c.Complex64() == x.Complex64()
+}
+
+// Eq is "==" operation.
+// See Complex32.Eq for details.
+func (c ComplexBF16) Eq(x ComplexBF16) bool {
+	return c.Complex64() == x.Complex64()
+}
+
+// Add is "+" operation.
+func (c Complex32) Add(x Complex32) Complex32 {
+	return c.Complex64().Add(x.Complex64()).Complex32()
+}
+
+// Add is "+" operation.
+func (c ComplexBF16) Add(x ComplexBF16) ComplexBF16 {
+	return c.Complex64().Add(x.Complex64()).ComplexBF16()
+}
+
+// Sub is "-" operation.
+func (c Complex32) Sub(x Complex32) Complex32 {
+	return c.Complex64().Sub(x.Complex64()).Complex32()
+}
+
+// Sub is "-" operation.
+func (c ComplexBF16) Sub(x ComplexBF16) ComplexBF16 {
+	return c.Complex64().Sub(x.Complex64()).ComplexBF16()
+}
+
+// Mul is "*" operation.
+func (c Complex32) Mul(x Complex32) Complex32 {
+	return c.Complex64().Mul(x.Complex64()).Complex32()
+}
+
+// Mul is "*" operation.
+func (c ComplexBF16) Mul(x ComplexBF16) ComplexBF16 {
+	return c.Complex64().Mul(x.Complex64()).ComplexBF16()
+}
+
+// Div is "/" operation.
+func (c Complex32) Div(x Complex32) Complex32 {
+	return c.Complex64().Div(x.Complex64()).Complex32()
+}
+
+// Div is "/" operation.
+func (c ComplexBF16) Div(x ComplexBF16) ComplexBF16 {
+	return c.Complex64().Div(x.Complex64()).ComplexBF16()
+}
+
+// float32toHalf rounds f to the nearest binary16 value.
+func float32toHalf(f float32) uint16 {
+	b := float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int(b>>23) & 0xff
+	mant := b & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			// Keep the upper payload bits, but make sure it stays a NaN.
+			return sign | 0x7e00 | uint16(mant>>13)
+		}
+		return sign | 0x7c00
+	}
+
+	// Half precision biased exponent.
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+	var r, rem, halfway uint32
+	if e > 0 {
+		r = uint32(e)<<10 | mant>>13
+		rem = mant & 0x1fff
+		halfway = 0x1000
+	} else {
+		// Subnormal result, the implicit bit becomes explicit.
+		shift := uint(14 - e)
+		if shift > 24 {
+			return sign
+		}
+		m := mant | 0x800000
+		r = m >> shift
+		rem = m & (1<<shift - 1)
+		halfway = 1 << (shift - 1)
+	}
+	// Carry out of the mantissa increments the exponent,
+	// which is exactly what rounding requires (up to infinity).
+	if rem > halfway || rem == halfway && r&1 == 1 {
+		r++
+	}
+	return sign | uint16(r)
+}
+
+// halfToFloat32 returns float32 value of binary16 h.
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+	switch exp {
+	case 0x1f:
+		return float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		// Zero or subnormal; the product is exact.
+		f := float32(mant) * 0x1p-24
+		return float32frombits(sign | float32bits(f))
+	}
+	return float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// float32toBF16 rounds f to the nearest bfloat16 value.
+func float32toBF16(f float32) uint16 {
+	b := float32bits(f)
+	if b&0x7fffffff > 0x7f800000 {
+		// NaN: truncate the payload, but keep it a quiet NaN.
+		return uint16(b>>16) | 0x40
+	}
+	// Round to nearest even by adding a bias that only carries
+	// past the lower half when it's above the halfway point
+	// (or equal to it for odd results).
+	return uint16((b + 0x7fff + (b>>16)&1) >> 16)
+}
+
+// bf16ToFloat32 returns float32 value of bfloat16 h.
+func bf16ToFloat32(h uint16) float32 {
+	return float32frombits(uint32(h) << 16)
+}
@@ -0,0 +1,211 @@
+package xmath
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttSameBits32 reports whether x and y are the same float32
+// values, all NaNs are considered the same.
+func ttSameBits32(x, y float32) bool {
+	return math.Float32bits(x) == math.Float32bits(y) || (x != x && y != y)
+}
+
+// ttHalfCodec describes a 16-bit float format for table-driven tests.
+type ttHalfCodec struct {
+	name string
+	// maxFinite is the largest finite bit pattern.
+	maxFinite uint16
+	// maxNext is the value that would follow maxFinite
+	// if the format had more exponent bits.
+	maxNext  float32
+	toHalf   func(float32) uint16
+	toSingle func(uint16) float32
+}
+
+var ttHalfCodecs = []ttHalfCodec{
+	{"binary16", 0x7bff, 65536, float32toHalf, halfToFloat32},
+	{"bfloat16", 0x7f7f, 0, float32toBF16, bf16ToFloat32},
+}
+
+// Unit tests.
+
+func TestHalfExhaustive(t *testing.T) {
+	for _, codec := range ttHalfCodecs {
+		for h := 0; h <= math.MaxUint16; h++ {
+			f := codec.toSingle(uint16(h))
+			back := codec.toHalf(f)
+			if f != f {
+				// NaN payloads may be quieted, signs are kept.
+				if g := codec.toSingle(back); g == g || back&0x8000 != uint16(h)&0x8000 {
+					t.Errorf("%s %#04x: NaN round trip failed: %#04x", codec.name, h, back)
+				}
+				continue
+			}
+			if back != uint16(h) {
+				t.Errorf("%s %#04x: round trip failed: %#04x", codec.name, h, back)
+			}
+		}
+	}
+}
+
+func TestHalfRounding(t *testing.T) {
+	for _, codec := range ttHalfCodecs {
+		for h := uint16(0); h <= codec.maxFinite; h++ {
+			lo := codec.toSingle(h)
+			var mid float32
+			if codec.maxNext != 0 {
+				hi := codec.toSingle(h + 1)
+				if h == codec.maxFinite {
+					hi = codec.maxNext
+				}
+				// Sums of adjacent values are exact in float32.
+				mid = (lo + hi) / 2
+			} else {
+				// bfloat16 is the upper half of float32.
+				mid = math.Float32frombits(math.Float32bits(lo) + 0x8000)
+			}
+			even := h
+			if h&1 == 1 {
+				even = h + 1
+			}
+			below := math.Nextafter32(mid, 0)
+			above := math.Nextafter32(mid, float32(math.Inf(1)))
+
+			tests := []struct {
+				x    float32
+				want uint16
+			}{
+				{lo, h},
+				{below, h},
+				{mid, even},
+				{above, h + 1},
+			}
+			for _, tt := range tests {
+				if have := codec.toHalf(tt.x); have != tt.want {
+					t.Errorf("%s(%v) failed;\nwant: %#04x\nhave: %#04x", codec.name, tt.x, tt.want, have)
+				}
+				// Rounding is symmetric.
+				if have := codec.toHalf(-tt.x); have != tt.want|0x8000 {
+					t.Errorf("%s(%v) failed;\nwant: %#04x\nhave: %#04x", codec.name, -tt.x, tt.want|0x8000, have)
+				}
+			}
+		}
+	}
+
+	inf := float32(math.Inf(1))
+	tests := []struct {
+		x    float32
+		want uint16
+	}{
+		{inf, 0x7c00},
+		{-inf, 0xfc00},
+		{1e10, 0x7c00},
+		{65519.996, 0x7bff},
+		{0x1p-25, 0},
+		{math.Nextafter32(0x1p-25, 1), 1},
+		{0x1p-126, 0},
+		{-0x1p-149, 0x8000},
+		{float32(math.NaN()), 0x7e00},
+	}
+	for _, tt := range tests {
+		if have := float32toHalf(tt.x); have != tt.want {
+			t.Errorf("binary16(%v) failed;\nwant: %#04x\nhave: %#04x", tt.x, tt.want, have)
+		}
+	}
+}
+
+func TestHalfArith(t *testing.T) {
+	rng := rand.New(rand.NewPCG(43, 0))
+	for k := 0; k < 100000; k++ {
+		r1, i1 := uint16(rng.Uint32()), uint16(rng.Uint32())
+		r2, i2 := uint16(rng.Uint32()), uint16(rng.Uint32())
+
+		x, y := Complex32FromBits(r1, i1), Complex32FromBits(r2, i2)
+		xb, yb := complex(x.Real(), x.Imag()), complex(y.Real(), y.Imag())
+		tests := []struct {
+			name string
+			have Complex32
+			want complex64
+		}{
+			{"+", x.Add(y), xb + yb},
+			{"-", x.Sub(y), xb - yb},
+			{"*", x.Mul(y), xb * yb},
+			{"/", x.Div(y), xb / yb},
+		}
+		for _, tt := range tests {
+			want := NewComplex64(real(tt.want), imag(tt.want)).Complex32().Complex64()
+			have := tt.have.Complex64()
+			if !ttSameBits32(have.r, want.r) || !ttSameBits32(have.i, want.i) {
+				t.Errorf("`%v%s%v` failed;\nwant: %v\nhave: %v", xb, tt.name, yb, want, have)
+			}
+		}
+
+		xf, yf := ComplexBF16FromBits(r1, i1), ComplexBF16FromBits(r2, i2)
+		xb, yb = complex(xf.Real(), xf.Imag()), complex(yf.Real(), yf.Imag())
+		testsBF16 := []struct {
+			name string
+			have ComplexBF16
+			want complex64
+		}{
+			{"+", xf.Add(yf), xb + yb},
+			{"-", xf.Sub(yf), xb - yb},
+			{"*", xf.Mul(yf), xb * yb},
+			{"/", xf.Div(yf), xb / yb},
+		}
+		for _, tt := range testsBF16 {
+			want := NewComplex64(real(tt.want), imag(tt.want)).ComplexBF16().Complex64()
+			have := tt.have.Complex64()
+			if !ttSameBits32(have.r, want.r) || !ttSameBits32(have.i, want.i) {
+				t.Errorf("`%v%s%v` failed;\nwant: %v\nhave: %v", xb, tt.name, yb, want, have)
+			}
+		}
+	}
+}
+
+func TestHalfLogical(t *testing.T) {
+	zero := Complex32FromBits(0, 0)
+	negZero := zero.Conj()
+	nan := NewComplex64(float32(math.NaN()), 0).Complex32()
+	one := NewComplex64(1, -1).Complex32()
+
+	if !negZero.IsZero() || !zero.Eq(negZero) {
+		t.Errorf("-0 must be equal to +0")
+	}
+	if nan.Eq(nan) || nan.IsZero() {
+		t.Errorf("NaN must not be equal to itself")
+	}
+	if r, i := one.Bits(); r != 0x3c00 || i != 0xbc00 {
+		t.Errorf("1-1i bits: have %#04x, %#04x", r, i)
+	}
+	if r, i := NewComplex64(1, -1).ComplexBF16().Bits(); r != 0x3f80 || i != 0xbf80 {
+		t.Errorf("1-1i bfloat16 bits: have %#04x, %#04x", r, i)
+	}
+	if !one.Conj().Eq(NewComplex64(1, 1).Complex32()) {
+		t.Errorf("conj(1-1i) failed")
+	}
+}
+
+// Performance tests.
+
+var (
+	ttHalf Complex32
+	ttBF16 ComplexBF16
+)
+
+func BenchmarkComplex32(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttHalf = x.Complex32()
+		ttReal32 = ttHalf.Real()
+	})
+}
+
+func BenchmarkComplexBF16(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		ttBF16 = x.ComplexBF16()
+		ttReal32 = ttBF16.Real()
+	})
+}
@@ -0,0 +1,27 @@
+package xmath
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+)
+
+// Hash returns a hash of c that is consistent with Eq:
+// if c.Eq(x) then c.Hash(seed) == x.Hash(seed).
+//
+// Like builtin complex64 map keys, +0 and -0 parts
+// hash equally. NaN parts are hashed by their bit pattern,
+// which is allowed since NaN is never equal to anything.
+func (c Complex64) Hash(seed maphash.Seed) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint32(buf[0:], hashBits32(c.r))
+	binary.LittleEndian.PutUint32(buf[4:], hashBits32(c.i))
+	return maphash.Bytes(seed, buf[:])
+}
+
+// hashBits32 returns f bits with negative zero mapped to positive zero.
+func hashBits32(f float32) uint32 {
+	if f == 0 {
+		return 0
+	}
+	return float32bits(f)
+}
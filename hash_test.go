@@ -0,0 +1,131 @@
+package xmath
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// ttSpecialParts32 are float32 values that are known to
+// have unusual comparison or hashing semantics.
+var ttSpecialParts32 = []float32{
+	0,
+	float32(math.Copysign(0, -1)),
+	1,
+	-1,
+	math.SmallestNonzeroFloat32,
+	math.MaxFloat32,
+	float32(math.Inf(+1)),
+	float32(math.Inf(-1)),
+	float32(math.NaN()),
+	math.Float32frombits(0xffc00001), // Negative NaN with payload.
+}
+
+// ttSpecialValues64 returns all combinations of ttSpecialParts32.
+func ttSpecialValues64() []complex64 {
+	var values []complex64
+	for _, r := range ttSpecialParts32 {
+		for _, i := range ttSpecialParts32 {
+			values = append(values, complex(r, i))
+		}
+	}
+	return values
+}
+
+func ttFromBuiltin64(x complex64) Complex64 {
+	return Complex64{r: real(x), i: imag(x)}
+}
+
+func TestComplex64MapKeys(t *testing.T) {
+	values := ttSpecialValues64()
+	for _, x := range values {
+		for _, y := range values {
+			mBuiltin := map[complex64]int{}
+			m := map[Complex64]int{}
+
+			mBuiltin[x]++
+			mBuiltin[x]++
+			m[ttFromBuiltin64(x)]++
+			m[ttFromBuiltin64(x)]++
+			if len(mBuiltin) != len(m) {
+				t.Errorf("double insert of %v: len mismatch;\nwant: %d\nhave: %d",
+					x, len(mBuiltin), len(m))
+			}
+
+			_, want := mBuiltin[y]
+			_, have := m[ttFromBuiltin64(y)]
+			if want != have {
+				t.Errorf("lookup %v in {%v}: failed;\nwant: %v\nhave: %v",
+					y, x, want, have)
+			}
+
+			delete(mBuiltin, y)
+			delete(m, ttFromBuiltin64(y))
+			if len(mBuiltin) != len(m) {
+				t.Errorf("delete %v from {%v}: len mismatch;\nwant: %d\nhave: %d",
+					y, x, len(mBuiltin), len(m))
+			}
+
+			clear(mBuiltin)
+			clear(m)
+			if len(m) != 0 {
+				t.Errorf("clear {%v}: map is not empty", x)
+			}
+		}
+	}
+}
+
+func TestComplex64Switch(t *testing.T) {
+	values := ttSpecialValues64()
+	for _, x := range values {
+		for _, y := range values {
+			want := false
+			switch x {
+			case y:
+				want = true
+			}
+			have := false
+			switch ttFromBuiltin64(x) {
+			case ttFromBuiltin64(y):
+				have = true
+			}
+			if want != have {
+				t.Errorf("`switch %v { case %v: }` failed;\nwant: %v\nhave: %v",
+					x, y, want, have)
+			}
+		}
+	}
+}
+
+func TestComplex64DeepEqual(t *testing.T) {
+	values := ttSpecialValues64()
+	for _, x := range values {
+		for _, y := range values {
+			want := reflect.DeepEqual(x, y)
+			have := reflect.DeepEqual(ttFromBuiltin64(x), ttFromBuiltin64(y))
+			if want != have {
+				t.Errorf("`DeepEqual(%v, %v)` failed;\nwant: %v\nhave: %v",
+					x, y, want, have)
+			}
+		}
+	}
+}
+
+func TestComplex64Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	values := ttSpecialValues64()
+	for _, x := range values {
+		for _, y := range values {
+			cx := ttFromBuiltin64(x)
+			cy := ttFromBuiltin64(y)
+			if cx.Eq(cy) && cx.Hash(seed) != cy.Hash(seed) {
+				t.Errorf("%v and %v are equal, but hashes differ", x, y)
+			}
+		}
+		cx := ttFromBuiltin64(x)
+		if cx.Hash(seed) != cx.Hash(seed) {
+			t.Errorf("%v hash is not deterministic", x)
+		}
+	}
+}
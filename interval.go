@@ -0,0 +1,201 @@
+package xmath
+
+import "math"
+
+// ComplexInterval is a rectangular complex interval:
+// a set of complex numbers with real and imaginary parts
+// inside [lo, hi] float64 intervals.
+//
+// Every operation rounds the bounds of the result outward,
+// so it contains exact results of the operation for any
+// points of the operand intervals.
+// Unbounded results are represented with infinite bounds.
+type ComplexInterval struct {
+	re interval
+	im interval
+}
+
+// interval is a closed [lo, hi] interval of real numbers.
+type interval struct {
+	lo float64
+	hi float64
+}
+
+// entire is an interval of all real numbers.
+var entire = interval{lo: math.Inf(-1), hi: math.Inf(1)}
+
+// NewComplexInterval returns [reLo, reHi] + [imLo, imHi]*i interval.
+// It panics if lo is greater than hi or any bound is NaN.
+func NewComplexInterval(reLo, reHi, imLo, imHi float64) ComplexInterval {
+	if !(reLo <= reHi) || !(imLo <= imHi) {
+		panic("xmath: invalid interval")
+	}
+	return ComplexInterval{
+		re: interval{lo: reLo, hi: reHi},
+		im: interval{lo: imLo, hi: imHi},
+	}
+}
+
+// Interval returns an interval that contains only c.
+func (c Complex128) Interval() ComplexInterval {
+	return NewComplexInterval(c.r, c.r, c.i, c.i)
+}
+
+// Real returns real part bounds.
+func (z ComplexInterval) Real() (lo, hi float64) { return z.re.lo, z.re.hi }
+
+// Imag returns imaginary part bounds.
+func (z ComplexInterval) Imag() (lo, hi float64) { return z.im.lo, z.im.hi }
+
+// Width returns widths of real and imaginary part intervals.
+// Widths that aren't representable exactly are rounded up.
+func (z ComplexInterval) Width() (re, im float64) {
+	return z.re.width(), z.im.width()
+}
+
+// Contains reports whether c is inside z.
+func (z ComplexInterval) Contains(c Complex128) bool {
+	return z.re.contains(c.r) && z.im.contains(c.i)
+}
+
+// ContainsInterval reports whether x is a subset of z.
+func (z ComplexInterval) ContainsInterval(x ComplexInterval) bool {
+	return z.re.lo <= x.re.lo && x.re.hi <= z.re.hi &&
+		z.im.lo <= x.im.lo && x.im.hi <= z.im.hi
+}
+
+// Round32 returns the smallest interval with float32 bounds that contains z.
+//
+// Complex64 operations round results to float32, so they
+// may be slightly outside of an interval computed for
+// exact results; they are always inside of its Round32 interval.
+func (z ComplexInterval) Round32() ComplexInterval {
+	return ComplexInterval{re: z.re.round32(), im: z.im.round32()}
+}
+
+// Add is "+" operation.
+func (z ComplexInterval) Add(x ComplexInterval) ComplexInterval {
+	return ComplexInterval{re: z.re.add(x.re), im: z.im.add(x.im)}
+}
+
+// Sub is "-" operation.
+func (z ComplexInterval) Sub(x ComplexInterval) ComplexInterval {
+	return ComplexInterval{re: z.re.sub(x.re), im: z.im.sub(x.im)}
+}
+
+// Mul is "*" operation.
+func (z ComplexInterval) Mul(x ComplexInterval) ComplexInterval {
+	return ComplexInterval{
+		re: z.re.mul(x.re).sub(z.im.mul(x.im)),
+		im: z.re.mul(x.im).add(z.im.mul(x.re)),
+	}
+}
+
+// Div is "/" operation.
+//
+// If x contains zero, the result is the entire complex plane.
+func (z ComplexInterval) Div(x ComplexInterval) ComplexInterval {
+	// z/x = z*conj(x) / |x|^2.
+	n := x.re.sqr().add(x.im.sqr())
+	return ComplexInterval{
+		re: z.re.mul(x.re).add(z.im.mul(x.im)).div(n),
+		im: z.im.mul(x.re).sub(z.re.mul(x.im)).div(n),
+	}
+}
+
+func (a interval) contains(x float64) bool {
+	return a.lo <= x && x <= a.hi
+}
+
+func (a interval) width() float64 {
+	if a.lo == a.hi {
+		return 0
+	}
+	d := a.hi - a.lo
+	if a.lo+d == a.hi {
+		// The subtraction is exact.
+		return d
+	}
+	return roundUp(d)
+}
+
+func (a interval) containsZero() bool {
+	return a.lo <= 0 && 0 <= a.hi
+}
+
+func (a interval) add(b interval) interval {
+	return interval{lo: roundDown(a.lo + b.lo), hi: roundUp(a.hi + b.hi)}
+}
+
+func (a interval) sub(b interval) interval {
+	return interval{lo: roundDown(a.lo - b.hi), hi: roundUp(a.hi - b.lo)}
+}
+
+func (a interval) mul(b interval) interval {
+	p1 := mulBound(a.lo, b.lo)
+	p2 := mulBound(a.lo, b.hi)
+	p3 := mulBound(a.hi, b.lo)
+	p4 := mulBound(a.hi, b.hi)
+	return interval{
+		lo: roundDown(min(p1, p2, p3, p4)),
+		hi: roundUp(max(p1, p2, p3, p4)),
+	}
+}
+
+// sqr returns a*a, which is tighter than a.mul(a)
+// for intervals that contain zero.
+func (a interval) sqr() interval {
+	l, h := a.lo*a.lo, a.hi*a.hi
+	if a.containsZero() {
+		return interval{lo: 0, hi: roundUp(max(l, h))}
+	}
+	return interval{lo: roundDown(min(l, h)), hi: roundUp(max(l, h))}
+}
+
+func (a interval) div(b interval) interval {
+	if b.containsZero() {
+		return entire
+	}
+	q1 := a.lo / b.lo
+	q2 := a.lo / b.hi
+	q3 := a.hi / b.lo
+	q4 := a.hi / b.hi
+	lo, hi := min(q1, q2, q3, q4), max(q1, q2, q3, q4)
+	if isNaN(lo) || isNaN(hi) {
+		// Inf/Inf, both operands are unbounded.
+		return entire
+	}
+	return interval{lo: roundDown(lo), hi: roundUp(hi)}
+}
+
+func (a interval) round32() interval {
+	lo, hi := float32(a.lo), float32(a.hi)
+	if float64(lo) > a.lo {
+		lo = math.Nextafter32(lo, float32(math.Inf(-1)))
+	}
+	if float64(hi) < a.hi {
+		hi = math.Nextafter32(hi, float32(math.Inf(1)))
+	}
+	return interval{lo: float64(lo), hi: float64(hi)}
+}
+
+// mulBound returns x*y for interval bounds,
+// where 0 * ±Inf is 0 instead of NaN.
+func mulBound(x, y float64) float64 {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	return x * y
+}
+
+// roundDown returns the next float64 towards -Inf.
+// Results of a single rounded operation are never
+// less than the exact value by more than this.
+func roundDown(x float64) float64 {
+	return math.Nextafter(x, math.Inf(-1))
+}
+
+// roundUp returns the next float64 towards +Inf.
+func roundUp(x float64) float64 {
+	return math.Nextafter(x, math.Inf(1))
+}
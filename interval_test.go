@@ -0,0 +1,161 @@
+package xmath
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttRandomBox returns a random interval with float32 bounds
+// and up to 10 float32 points inside of it, including corners.
+func ttRandomBox(rng *rand.Rand) (ComplexInterval, []Complex64) {
+	part := func() (lo, hi float32) {
+		scale := float32(math.Pow(10, float64(rng.IntN(7)-3)))
+		a := (rng.Float32()*2 - 1) * scale
+		// Some intervals are points, some are wide.
+		w := scale * []float32{0, 1e-6, 0.1, 2}[rng.IntN(4)] * rng.Float32()
+		return a, a + w
+	}
+	rlo, rhi := part()
+	ilo, ihi := part()
+	points := []Complex64{
+		NewComplex64(rlo, ilo), NewComplex64(rlo, ihi),
+		NewComplex64(rhi, ilo), NewComplex64(rhi, ihi),
+	}
+	for i := 0; i < 6; i++ {
+		r := rlo + (rhi-rlo)*rng.Float32()
+		im := ilo + (ihi-ilo)*rng.Float32()
+		points = append(points, NewComplex64(min(r, rhi), min(im, ihi)))
+	}
+	return NewComplexInterval(float64(rlo), float64(rhi), float64(ilo), float64(ihi)), points
+}
+
+// Unit tests.
+
+func TestComplexIntervalOps(t *testing.T) {
+	type op struct {
+		name     string
+		interval func(x, y ComplexInterval) ComplexInterval
+		lib      func(x, y Complex64) Complex64
+		big      func(z, x, y *BigComplex) *BigComplex
+	}
+	ops := []op{
+		{"+", ComplexInterval.Add, Complex64.Add, (*BigComplex).Add},
+		{"-", ComplexInterval.Sub, Complex64.Sub, (*BigComplex).Sub},
+		{"*", ComplexInterval.Mul, Complex64.Mul, (*BigComplex).Mul},
+		{"/", ComplexInterval.Div, Complex64.Div, (*BigComplex).Quo},
+	}
+
+	rng := rand.New(rand.NewPCG(46, 0))
+	for k := 0; k < 3000; k++ {
+		x, xs := ttRandomBox(rng)
+		y, ys := ttRandomBox(rng)
+		for _, op := range ops {
+			z := op.interval(x, y)
+			z32 := z.Round32()
+			if !z32.ContainsInterval(z) {
+				t.Fatalf("%v: Round32 result %v doesn't contain it", z, z32)
+			}
+			for _, a := range xs {
+				for _, b := range ys {
+					if op.name == "/" && b.IsZero() {
+						continue
+					}
+					// Exact result, rounded to nearest.
+					exact := op.big(new(BigComplex).SetPrec(300), new(BigComplex).SetComplex64(a), new(BigComplex).SetComplex64(b))
+					if want := exact.Complex128(); !z.Contains(want) {
+						t.Errorf("`%v%s%v`: %v is not inside %v", a, op.name, b, want, z)
+					}
+					if have := op.lib(a, b); !z32.Contains(have.Complex128()) {
+						t.Errorf("`%v%s%v`: Complex64 result %v is not inside %v", a, op.name, b, have, z32)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestComplexIntervalDivZero(t *testing.T) {
+	inf := math.Inf(1)
+	x := NewComplexInterval(1, 2, -1, 1)
+	tests := []struct {
+		name string
+		y    ComplexInterval
+		want ComplexInterval
+	}{
+		{"zero", NewComplexInterval(0, 0, 0, 0), NewComplexInterval(-inf, inf, -inf, inf)},
+		{"around zero", NewComplexInterval(-1, 1, -1e-300, 1), NewComplexInterval(-inf, inf, -inf, inf)},
+		{"corner", NewComplexInterval(0, 1, 0, 1), NewComplexInterval(-inf, inf, -inf, inf)},
+		{"entire", NewComplexInterval(-inf, inf, -inf, inf), NewComplexInterval(-inf, inf, -inf, inf)},
+	}
+	for _, tt := range tests {
+		if have := x.Div(tt.y); have != tt.want {
+			t.Errorf("%v/%v (%s) failed;\nwant: %v\nhave: %v", x, tt.y, tt.name, tt.want, have)
+		}
+	}
+
+	// Real parts contain zero, but the interval doesn't.
+	y := NewComplexInterval(-1, 1, 2, 3)
+	z := x.Div(y)
+	rw, iw := z.Width()
+	if math.IsInf(rw, 0) || math.IsInf(iw, 0) {
+		t.Errorf("%v/%v: want a bounded result, have %v", x, y, z)
+	}
+	if !z.Contains(NewComplex128(1, 1).Div(NewComplex128(0, 2))) {
+		t.Errorf("%v/%v: %v doesn't contain (1+i)/2i", x, y, z)
+	}
+}
+
+func TestComplexInterval(t *testing.T) {
+	z := NewComplex128(1, -3).Interval()
+	if lo, hi := z.Real(); lo != 1 || hi != 1 {
+		t.Errorf("Real: have [%v, %v]", lo, hi)
+	}
+	if rw, iw := z.Width(); rw != 0 || iw != 0 {
+		t.Errorf("point Width: have %v, %v", rw, iw)
+	}
+	// 2^53+1 is rounded to 2^53, which is less than the exact width.
+	if rw, iw := NewComplexInterval(-1, 0x1p53, 0.5, 2).Width(); rw != 0x1p53+2 || iw != 1.5 {
+		t.Errorf("Width: have %v, %v", rw, iw)
+	}
+
+	// Outward rounding never loses inexact sums.
+	a, b := 0.1, 0.2
+	sum := NewComplex128(a, 0).Interval().Add(NewComplex128(b, 0).Interval())
+	if lo, hi := sum.Real(); !(lo < a+b && a+b < hi) {
+		t.Errorf("0.1+0.2: have [%v, %v]", lo, hi)
+	}
+
+	wide := NewComplexInterval(-1, 1, -2, 2)
+	if !wide.ContainsInterval(z.Sub(z)) || wide.ContainsInterval(z) || z.Contains(NewComplex128(1, 0)) {
+		t.Errorf("containment checks failed")
+	}
+	if have, want := NewComplexInterval(0.1, 0.1, -0.1, -0.1).Round32(), NewComplexInterval(
+		float64(float32(0.1)-0x1p-27), float64(float32(0.1)), float64(-float32(0.1)), float64(-float32(0.1)+0x1p-27)); have != want {
+		t.Errorf("Round32 failed;\nwant: %v\nhave: %v", want, have)
+	}
+
+	for _, bounds := range [][4]float64{{1, 0, 0, 0}, {0, 0, 0, math.NaN()}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v: expected a panic", bounds)
+				}
+			}()
+			NewComplexInterval(bounds[0], bounds[1], bounds[2], bounds[3])
+		}()
+	}
+}
+
+// Performance tests.
+
+var ttInterval ComplexInterval
+
+func BenchmarkMulInterval(b *testing.B) {
+	bench128(b.N, func(x, y Complex128) {
+		p, q := x.Interval(), y.Interval()
+		ttInterval = p.Mul(q).Mul(p).Mul(q)
+	})
+}
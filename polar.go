@@ -0,0 +1,98 @@
+package xmath
+
+import "math"
+
+// Polar64 is a complex number in polar form: r*e^(i*theta),
+// with float32 magnitude and phase.
+//
+// Mul and Div are a single multiplication (division) and addition
+// (subtraction), so long chains of rotations are cheaper than
+// with Complex64. Add and Sub require a conversion to Complex64.
+//
+// Mul, Div and Pow don't wrap the phase, it may leave [-Pi, Pi]
+// range; use Normalize to wrap it back.
+// This type has value semantics, all operations return a
+// new instance of Polar64.
+type Polar64 struct {
+	r     float32
+	theta float32
+}
+
+// NewPolar64 returns r*e^(i*theta) complex number.
+func NewPolar64(r, theta float32) Polar64 {
+	return Polar64{r: r, theta: theta}
+}
+
+// Polar64 converts c to polar form.
+// Magnitude and phase are computed with float64 precision
+// and rounded once; the phase is in the range [-Pi32, Pi32],
+// where Pi32 = float32(Pi) is slightly greater than Pi.
+func (c Complex64) Polar64() Polar64 {
+	r, i := float64(c.r), float64(c.i)
+	return Polar64{r: float32(math.Hypot(r, i)), theta: float32(math.Atan2(i, r))}
+}
+
+// Complex64 converts p to Complex64.
+// Parts are computed with float64 precision and rounded once.
+// For infinite magnitude, parts with sine or cosine below
+// the phase rounding error (for phases that are float32
+// approximations of multiples of Pi/2) are zeros instead of
+// NaNs or infinities, so (±Inf, 0) and (0, ±Inf) survive
+// a round trip through Polar64.
+func (p Polar64) Complex64() Complex64 {
+	s, c := math.Sincos(float64(p.theta))
+	r := float64(p.r)
+	return Complex64{r: float32(polarPart(r, c)), i: float32(polarPart(r, s))}
+}
+
+// polarPart returns r*t, where r is a magnitude and t is
+// a sine or cosine of a float32 phase.
+func polarPart(r, t float64) float64 {
+	// Phases in [-Pi, Pi] have rounding errors up to 2^-23,
+	// so smaller t can't be distinguished from 0.
+	if isInf(r) && abs(t) <= 0x1p-22 {
+		r = copysign(0, r)
+	}
+	return r * t
+}
+
+// Abs returns the magnitude of p.
+func (p Polar64) Abs() float32 { return p.r }
+
+// Phase returns the phase of p.
+func (p Polar64) Phase() float32 { return p.theta }
+
+// Conj returns the complex conjugate of p.
+func (p Polar64) Conj() Polar64 {
+	return Polar64{r: p.r, theta: -p.theta}
+}
+
+// Mul is "*" operation.
+func (p Polar64) Mul(x Polar64) Polar64 {
+	return Polar64{r: p.r * x.r, theta: p.theta + x.theta}
+}
+
+// Div is "/" operation.
+func (p Polar64) Div(x Polar64) Polar64 {
+	return Polar64{r: p.r / x.r, theta: p.theta - x.theta}
+}
+
+// Pow returns p**n, where the phase of the result is n*p.Phase().
+// Normalize p first to get the principal value.
+func (p Polar64) Pow(n float32) Polar64 {
+	r := math.Pow(float64(p.r), float64(n))
+	return Polar64{r: float32(r), theta: float32(float64(n) * float64(p.theta))}
+}
+
+// Normalize returns p with non-negative magnitude
+// and phase wrapped to the range [-Pi32, Pi32]
+// (see Complex64.Polar64).
+func (p Polar64) Normalize() Polar64 {
+	theta := float64(p.theta)
+	r := p.r
+	if r < 0 {
+		r = -r
+		theta += math.Pi
+	}
+	return Polar64{r: r, theta: float32(math.Remainder(theta, 2*math.Pi))}
+}
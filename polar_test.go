@@ -0,0 +1,184 @@
+package xmath
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttPolarValues returns random finite values of moderate magnitudes.
+func ttPolarValues() []Complex64 {
+	rng := rand.New(rand.NewPCG(49, 0))
+	xs := make([]Complex64, 1000)
+	for i := range xs {
+		r := math.Exp(rng.Float64()*20 - 10)
+		theta := (rng.Float64()*2 - 1) * math.Pi
+		xs[i] = NewComplex64(float32(r*math.Cos(theta)), float32(r*math.Sin(theta)))
+	}
+	return xs
+}
+
+// ttPolarDist returns |have - want| / |want|.
+func ttPolarDist(have Complex64, want complex128) float64 {
+	return cmplx.Abs(complex(float64(have.r), float64(have.i))-want) / cmplx.Abs(want)
+}
+
+// Unit tests.
+
+func TestPolar64Conversion(t *testing.T) {
+	const u = 0x1p-24
+	for _, x := range ttPolarValues() {
+		p := x.Polar64()
+		if p.Phase() < -float32(math.Pi) || p.Phase() > float32(math.Pi) {
+			t.Errorf("%v: phase %v is out of range", x, p.Phase())
+		}
+		// Magnitude and phase roundings give u*(1+Pi) relative error,
+		// the final rounding adds u per part.
+		want := complex(float64(x.r), float64(x.i))
+		if d := ttPolarDist(p.Complex64(), want); d > u*(3+math.Pi) {
+			t.Errorf("%v: round trip through %v gives %v (%.1fu)", x, p, p.Complex64(), d/u)
+		}
+	}
+
+	tests := []struct {
+		x Complex64
+		p Polar64
+	}{
+		{NewComplex64(1, 0), NewPolar64(1, 0)},
+		{NewComplex64(0, 2), NewPolar64(2, math.Pi/2)},
+		{NewComplex64(-3, 0), NewPolar64(3, math.Pi)},
+		{NewComplex64(3, 4), NewPolar64(5, float32(math.Atan2(4, 3)))},
+		{NewComplex64(0, 0), NewPolar64(0, 0)},
+	}
+	for _, tt := range tests {
+		if have := tt.x.Polar64(); have != tt.p {
+			t.Errorf("%v to polar failed;\nwant: %v\nhave: %v", tt.x, tt.p, have)
+		}
+	}
+}
+
+func TestPolar64Inf(t *testing.T) {
+	inf := float32(math.Inf(1))
+	negZero := float32(math.Copysign(0, -1))
+	tests := []struct {
+		p    Polar64
+		want Complex64
+	}{
+		{NewPolar64(inf, 0), NewComplex64(inf, 0)},
+		{NewPolar64(-inf, 0), NewComplex64(-inf, negZero)},
+		{NewPolar64(inf, math.Pi/2), NewComplex64(negZero, inf)},
+		{NewPolar64(inf, -math.Pi), NewComplex64(-inf, 0)},
+		{NewPolar64(inf, math.Pi/4), NewComplex64(inf, inf)},
+		{NewPolar64(1, math.Pi/2), NewComplex64(float32(math.Cos(float64(float32(math.Pi/2)))), 1)},
+		{NewComplex64(inf, 0).Polar64(), NewComplex64(inf, 0)},
+		// The phase is float32(Pi) > Pi, its sine is negative.
+		{NewComplex64(-inf, 0).Polar64(), NewComplex64(-inf, negZero)},
+		{NewComplex64(0, -inf).Polar64(), NewComplex64(negZero, -inf)},
+	}
+	for _, tt := range tests {
+		have := tt.p.Complex64()
+		if !ttSameBits32(have.r, tt.want.r) || !ttSameBits32(have.i, tt.want.i) {
+			t.Errorf("%v to complex failed;\nwant: %v\nhave: %v", tt.p, tt.want, have)
+		}
+	}
+}
+
+func TestPolar64Arith(t *testing.T) {
+	const u = 0x1p-24
+	xs := ttPolarValues()
+	for i, x := range xs {
+		y := xs[(i*7+1)%len(xs)]
+		p, q := x.Polar64(), y.Polar64()
+		xb := complex(float64(x.r), float64(x.i))
+		yb := complex(float64(y.r), float64(y.i))
+
+		tests := []struct {
+			name string
+			have Polar64
+			want complex128
+			// Phase error of the result, in units of u.
+			phase float64
+		}{
+			{"*", p.Mul(q), xb * yb, 3 * math.Pi},
+			{"/", p.Div(q), xb / yb, 3 * math.Pi},
+			{"conj", p.Conj(), cmplx.Conj(xb), math.Pi},
+			{"^3", p.Pow(3), xb * xb * xb, 4 * 3 * math.Pi},
+			{"^0.5", p.Pow(0.5), cmplx.Sqrt(xb), 2 * math.Pi},
+		}
+		for _, tt := range tests {
+			if d := ttPolarDist(tt.have.Complex64(), tt.want); d > u*(6+tt.phase) {
+				t.Errorf("%v %s %v failed (%.1fu);\nwant: %v\nhave: %v", x, tt.name, y, d/u, tt.want, tt.have.Complex64())
+			}
+		}
+	}
+}
+
+func TestPolar64Normalize(t *testing.T) {
+	tests := []struct {
+		p    Polar64
+		want Polar64
+	}{
+		{NewPolar64(1, 0), NewPolar64(1, 0)},
+		{NewPolar64(1, 3*math.Pi/2), NewPolar64(1, -math.Pi/2)},
+		{NewPolar64(2, -5*math.Pi/2), NewPolar64(2, -math.Pi/2)},
+		{NewPolar64(-2, math.Pi/4), NewPolar64(2, -3*math.Pi/4)},
+		{NewPolar64(1, 100), NewPolar64(1, float32(math.Remainder(float64(float32(100)), 2*math.Pi)))},
+		// The result is float32(Pi), which is slightly greater than Pi.
+		{NewPolar64(-1, 0), NewPolar64(1, math.Pi)},
+	}
+	for _, tt := range tests {
+		have := tt.p.Normalize()
+		if have.Abs() != tt.want.Abs() || math.Abs(float64(have.Phase()-tt.want.Phase())) > 1e-6 {
+			t.Errorf("normalize(%v) failed;\nwant: %v\nhave: %v", tt.p, tt.want, have)
+		}
+	}
+
+	// A chain of rotations accumulates the phase.
+	step := NewPolar64(1, 0.1)
+	p := NewPolar64(1, 0)
+	for i := 0; i < 100; i++ {
+		p = p.Mul(step)
+	}
+	if p.Phase() < 9.99 || p.Normalize().Phase() > float32(math.Pi) {
+		t.Errorf("rotation chain: have %v, normalized %v", p, p.Normalize())
+	}
+}
+
+// Performance tests.
+
+// ttRotations is the length of the rotation chains.
+const ttRotations = 16
+
+var ttPolar Polar64
+
+func BenchmarkRotateBuiltin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		for i := 0; i < ttRotations; i++ {
+			x *= y
+		}
+		ttReal32 = real(x)
+	})
+}
+
+func BenchmarkRotate(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		for i := 0; i < ttRotations; i++ {
+			x = x.Mul(y)
+		}
+		ttReal32 = x.Real()
+	})
+}
+
+func BenchmarkRotatePolar(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		// Conversions are included; they are amortized by long chains.
+		p, q := x.Polar64(), y.Polar64()
+		for i := 0; i < ttRotations; i++ {
+			p = p.Mul(q)
+		}
+		ttPolar = p
+	})
+}
@@ -0,0 +1,180 @@
+package xmath
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNoConvergence is returned when an iterative algorithm
+// fails to converge.
+var ErrNoConvergence = errors.New("xmath: no convergence")
+
+// Poly64 is a polynomial with Complex64 coefficients.
+//
+// p[i] is the coefficient of x^i, so the polynomial
+// 3 + 2x + x^2 is represented as Poly64{3, 2, 1}.
+// Trailing zero coefficients are allowed and ignored.
+type Poly64 []Complex64
+
+// Degree returns the polynomial degree.
+// The zero polynomial has degree -1.
+func (p Poly64) Degree() int {
+	return len(p.trim()) - 1
+}
+
+// Eval returns p(x), evaluated with Horner's method.
+func (p Poly64) Eval(x Complex64) Complex64 {
+	var v Complex64
+	for i := len(p) - 1; i >= 0; i-- {
+		v = v.Mul(x).Add(p[i])
+	}
+	return v
+}
+
+// EvalDeriv returns p(x) and p'(x), evaluated with Horner's method.
+func (p Poly64) EvalDeriv(x Complex64) (v, d Complex64) {
+	for i := len(p) - 1; i >= 0; i-- {
+		d = d.Mul(x).Add(v)
+		v = v.Mul(x).Add(p[i])
+	}
+	return v, d
+}
+
+// Mul returns p*q.
+func (p Poly64) Mul(q Poly64) Poly64 {
+	p, q = p.trim(), q.trim()
+	if len(p) == 0 || len(q) == 0 {
+		return Poly64{}
+	}
+	res := make(Poly64, len(p)+len(q)-1)
+	for i, x := range p {
+		for j, y := range q {
+			res[i+j].MulAddAssign(x, y)
+		}
+	}
+	return res
+}
+
+// Div returns the quotient and the remainder of p/q:
+// p = quo*q + rem, where rem.Degree() < q.Degree().
+// It panics if q is the zero polynomial.
+func (p Poly64) Div(q Poly64) (quo, rem Poly64) {
+	p, q = p.trim(), q.trim()
+	if len(q) == 0 {
+		panic("xmath: polynomial division by zero")
+	}
+	rem = append(Poly64{}, p...)
+	if len(p) < len(q) {
+		return Poly64{}, rem
+	}
+	dq := len(q) - 1
+	lead := q[dq]
+	quo = make(Poly64, len(p)-dq)
+	for i := len(quo) - 1; i >= 0; i-- {
+		c := rem[i+dq].Div(lead)
+		quo[i] = c
+		for j := 0; j < dq; j++ {
+			rem[i+j].SubAssign(c.Mul(q[j]))
+		}
+		rem[i+dq] = Complex64{}
+	}
+	return quo, rem[:dq].trim()
+}
+
+// maxAberthIterations is the maximum number of Aberth-Ehrlich
+// iterations performed by Roots.
+const maxAberthIterations = 100
+
+// Roots returns all roots of p, repeated according to their
+// multiplicity, found with the Aberth-Ehrlich method.
+// It returns nil for polynomials of degree less than 1.
+//
+// A root is considered found when |p(z)| is below the rounding
+// error bound of the Horner evaluation, or when the correction
+// becomes negligible.
+// If some roots don't converge, the best approximations are
+// returned along with ErrNoConvergence.
+func (p Poly64) Roots() ([]Complex64, error) {
+	p = p.trim()
+	var roots []Complex64
+	// Zero roots are factored out exactly.
+	for len(p) > 1 && p[0].IsZero() {
+		roots = append(roots, Complex64{})
+		p = p[1:]
+	}
+	n := len(p) - 1
+	if n <= 0 {
+		return roots, nil
+	}
+
+	abs := make([]float64, len(p))
+	for i, a := range p {
+		abs[i] = float64(a.Abs())
+	}
+
+	// Initial approximations are placed on the circle with
+	// the radius equal to the geometric mean of the roots moduli.
+	// The angle offset breaks symmetry for real polynomials.
+	z := make([]Complex64, n)
+	radius := math.Pow(abs[0]/abs[n], 1/float64(n))
+	for k := range z {
+		sin, cos := math.Sincos(2*math.Pi*float64(k)/float64(n) + 0.4)
+		z[k] = NewComplex64(float32(radius*cos), float32(radius*sin))
+	}
+
+	one := NewComplex64(1, 0)
+	converged := make([]bool, n)
+	left := n
+	for iter := 0; iter < maxAberthIterations && left > 0; iter++ {
+		for k := range z {
+			if converged[k] {
+				continue
+			}
+			v, d := p.EvalDeriv(z[k])
+			if float64(v.Abs()) <= hornerErrorBound(abs, float64(z[k].Abs())) {
+				converged[k] = true
+				left--
+				continue
+			}
+			var sum Complex64
+			for j := range z {
+				if j != k {
+					sum.AddAssign(one.Div(z[k].Sub(z[j])))
+				}
+			}
+			// w = 1 / (p'/p - sum 1/(z[k]-z[j])).
+			w := one.Div(d.Div(v).Sub(sum))
+			z[k].SubAssign(w)
+			if float64(w.Abs()) <= 0x1p-24*float64(z[k].Abs()) {
+				converged[k] = true
+				left--
+			}
+		}
+	}
+
+	roots = append(roots, z...)
+	if left > 0 {
+		return roots, ErrNoConvergence
+	}
+	return roots, nil
+}
+
+// hornerErrorBound returns the rounding error bound for
+// Horner evaluation of a float32 polynomial at |z| = x,
+// given the coefficient moduli abs.
+func hornerErrorBound(abs []float64, x float64) float64 {
+	var s float64
+	for i := len(abs) - 1; i >= 0; i-- {
+		s = s*x + abs[i]
+	}
+	return 2 * float64(len(abs)) * 0x1p-24 * s
+}
+
+// trim returns p without trailing zero coefficients.
+func (p Poly64) trim() Poly64 {
+	n := len(p)
+	for n > 0 && p[n-1].IsZero() {
+		n--
+	}
+	return p[:n]
+}
@@ -0,0 +1,365 @@
+package xmath
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+// Builtin complex64 implementation of the same algorithms.
+// It performs exactly the same operations in the same order.
+
+func ttPolyEvalDerivBuiltin(p []complex64, x complex64) (v, d complex64) {
+	for i := len(p) - 1; i >= 0; i-- {
+		d = d*x + v
+		v = v*x + p[i]
+	}
+	return v, d
+}
+
+func ttPolyRootsBuiltin(p []complex64) []complex64 {
+	n := len(p) - 1
+	abs := make([]float64, len(p))
+	for i, a := range p {
+		abs[i] = float64(float32(cmplx.Abs(complex128(a))))
+	}
+	absz := func(x complex64) float64 {
+		return float64(float32(cmplx.Abs(complex128(x))))
+	}
+
+	z := make([]complex64, n)
+	radius := math.Pow(abs[0]/abs[n], 1/float64(n))
+	for k := range z {
+		sin, cos := math.Sincos(2*math.Pi*float64(k)/float64(n) + 0.4)
+		z[k] = complex(float32(radius*cos), float32(radius*sin))
+	}
+
+	converged := make([]bool, n)
+	left := n
+	for iter := 0; iter < maxAberthIterations && left > 0; iter++ {
+		for k := range z {
+			if converged[k] {
+				continue
+			}
+			v, d := ttPolyEvalDerivBuiltin(p, z[k])
+			if absz(v) <= hornerErrorBound(abs, absz(z[k])) {
+				converged[k] = true
+				left--
+				continue
+			}
+			var sum complex64
+			for j := range z {
+				if j != k {
+					sum += 1 / (z[k] - z[j])
+				}
+			}
+			w := 1 / (d/v - sum)
+			z[k] -= w
+			if absz(w) <= 0x1p-24*absz(z[k]) {
+				converged[k] = true
+				left--
+			}
+		}
+	}
+	return z
+}
+
+// ttPolyFromRoots returns the monic polynomial with given roots.
+func ttPolyFromRoots(roots []complex64) Poly64 {
+	p := Poly64{NewComplex64(1, 0)}
+	for _, r := range roots {
+		p = p.Mul(Poly64{ttFromBuiltin64(-r), NewComplex64(1, 0)})
+	}
+	return p
+}
+
+// ttMatchRoots returns the maximum distance between want roots
+// and the nearest unmatched have roots.
+func ttMatchRoots(have []Complex64, want []complex64) float64 {
+	used := make([]bool, len(have))
+	var maxDist float64
+	for _, w := range want {
+		best, bestDist := -1, math.Inf(+1)
+		for i, h := range have {
+			d := cmplx.Abs(complex128(complex(h.r, h.i)) - complex128(w))
+			if !used[i] && d < bestDist {
+				best, bestDist = i, d
+			}
+		}
+		used[best] = true
+		maxDist = math.Max(maxDist, bestDist)
+	}
+	return maxDist
+}
+
+// ttRandomPoly returns a degree n polynomial with coefficients
+// from the [-1, 1) box.
+func ttRandomPoly(seed uint64, n int) Poly64 {
+	r := rand.New(rand.NewPCG(seed, 0))
+	p := make(Poly64, max(n+1, 0))
+	for i := range p {
+		p[i] = NewComplex64(2*r.Float32()-1, 2*r.Float32()-1)
+	}
+	return p
+}
+
+// Unit tests.
+
+func TestPoly64Eval(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		p := ttRandomPoly(uint64(n), n)
+		for _, v := range ttValues {
+			x, _ := ttUnpack64Builtin(v)
+			want, wantDeriv := ttPolyEvalDerivBuiltin(ttToBuiltinPoly(p), x)
+			have, haveDeriv := p.EvalDeriv(ttFromBuiltin64(x))
+			if !ttSame64(want, have) || !ttSame64(wantDeriv, haveDeriv) {
+				t.Errorf("`p(%v)` failed;\nwant: %v, %v\nhave: %v, %v",
+					x, want, wantDeriv, have, haveDeriv)
+			}
+			if have := p.Eval(ttFromBuiltin64(x)); !ttSame64(want, have) {
+				t.Errorf("`p(%v)` failed;\nwant: %v\nhave: %v", x, want, have)
+			}
+		}
+	}
+}
+
+func TestPoly64Degree(t *testing.T) {
+	one := NewComplex64(1, 0)
+	tests := []struct {
+		p    Poly64
+		want int
+	}{
+		{nil, -1},
+		{Poly64{{}}, -1},
+		{Poly64{one}, 0},
+		{Poly64{one, one}, 1},
+		{Poly64{one, {}, one, {}, {}}, 2},
+	}
+	for _, test := range tests {
+		if have := test.p.Degree(); have != test.want {
+			t.Errorf("%v.Degree():\nwant: %d\nhave: %d", test.p, test.want, have)
+		}
+	}
+}
+
+func TestPoly64MulDiv(t *testing.T) {
+	for n := 0; n < 8; n++ {
+		for m := 0; m < 5; m++ {
+			p := ttRandomPoly(uint64(n), n)
+			q := ttRandomPoly(uint64(m)+50, m)
+
+			prod := p.Mul(q)
+			want := make([]complex64, n+m+1)
+			for i, x := range ttToBuiltinPoly(p) {
+				for j, y := range ttToBuiltinPoly(q) {
+					want[i+j] += x * y
+				}
+			}
+			for i := range want {
+				if !ttSame64(want[i], prod[i]) {
+					t.Errorf("deg %d * deg %d: coefficient %d differs\nwant: %v\nhave: %v",
+						n, m, i, want[i], prod[i])
+				}
+			}
+
+			// (p*q + r) / q = p, r.
+			r := ttRandomPoly(uint64(m)+100, m-1)
+			num := append(Poly64{}, prod...)
+			for i, x := range r {
+				num[i].AddAssign(x)
+			}
+			quo, rem := num.Div(q)
+			if quo.Degree() != n || rem.Degree() >= q.Degree() {
+				t.Fatalf("deg %d / deg %d: quotient degree %d, remainder degree %d",
+					n+m, m, quo.Degree(), rem.Degree())
+			}
+			// Reconstruct num and compare.
+			back := quo.Mul(q)
+			for i, x := range rem {
+				back[i].AddAssign(x)
+			}
+			for i := range num {
+				d := cmplx.Abs(complex128(complex(back[i].r-num[i].r, back[i].i-num[i].i)))
+				if d > 1e-5*(1+float64(num[i].Abs())) {
+					t.Errorf("deg %d / deg %d: quo*q + rem differs at %d:\nwant: %v\nhave: %v",
+						n+m, m, i, num[i], back[i])
+				}
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("division by zero polynomial: expected a panic")
+		}
+	}()
+	Poly64{NewComplex64(1, 0)}.Div(Poly64{{}})
+}
+
+func TestPoly64RootsKnown(t *testing.T) {
+	unity := func(n int) []complex64 {
+		roots := make([]complex64, n)
+		for k := range roots {
+			roots[k] = complex64(cmplx.Rect(1, 2*math.Pi*float64(k)/float64(n)))
+		}
+		return roots
+	}
+	// xn1 returns x^n - 1 polynomial.
+	xn1 := func(n int) Poly64 {
+		p := make(Poly64, n+1)
+		p[0] = NewComplex64(-1, 0)
+		p[n] = NewComplex64(1, 0)
+		return p
+	}
+	tests := []struct {
+		p     Poly64
+		roots []complex64
+		tol   float64
+	}{
+		{nil, []complex64{5}, 1e-6},
+		{nil, []complex64{1, 2, 3}, 1e-5},
+		{nil, []complex64{1i, -1i}, 1e-6},
+		{nil, []complex64{0, 0, 2 + 1i}, 1e-6},
+		{nil, []complex64{-1, 0.5 - 2i, 3 + 3i, -2 - 0.25i}, 1e-5},
+		{nil, []complex64{1e-3, 1, 1e3}, 1e-3},
+		{xn1(5), unity(5), 1e-6},
+		{xn1(16), unity(16), 1e-6},
+		// Double roots can be found with sqrt(eps) accuracy only.
+		{nil, []complex64{2, 2, -1}, 5e-3},
+	}
+	for _, test := range tests {
+		p := test.p
+		if p == nil {
+			p = ttPolyFromRoots(test.roots)
+		}
+		have, err := p.Roots()
+		if err != nil {
+			t.Errorf("%v: %v", test.roots, err)
+		}
+		if len(have) != len(test.roots) {
+			t.Fatalf("%v: want %d roots, have %d", test.roots, len(test.roots), len(have))
+		}
+		if dist := ttMatchRoots(have, test.roots); dist > test.tol {
+			t.Errorf("%v: roots error %g exceeds %g\nhave: %v", test.roots, dist, test.tol, have)
+		}
+	}
+
+	for _, p := range []Poly64{nil, {{}}, {NewComplex64(3, 1)}} {
+		if roots, err := p.Roots(); roots != nil || err != nil {
+			t.Errorf("%v.Roots(): want nil, have %v, %v", p, roots, err)
+		}
+	}
+}
+
+func TestPoly64RootsBuiltin(t *testing.T) {
+	for n := 1; n < 20; n++ {
+		p := ttRandomPoly(uint64(n), n)
+		if p[0].IsZero() || p[n].IsZero() {
+			continue
+		}
+		have, err := p.Roots()
+		if err != nil {
+			t.Errorf("deg %d: %v", n, err)
+		}
+		want := ttPolyRootsBuiltin(ttToBuiltinPoly(p))
+		for i := range want {
+			if !ttSame64(want[i], have[i]) {
+				t.Errorf("deg %d: root %d differs from builtin\nwant: %v\nhave: %v",
+					n, i, want[i], have[i])
+			}
+		}
+		for _, z := range have {
+			if v := p.Eval(z); float64(v.Abs()) > hornerErrorBound(ttAbsPoly(p), float64(z.Abs()))*10 {
+				t.Errorf("deg %d: |p(%v)| = %v is too big", n, z, v.Abs())
+			}
+		}
+	}
+}
+
+func TestPoly64RootsNoConvergence(t *testing.T) {
+	p := Poly64{NewComplex64(float32(math.NaN()), 0), NewComplex64(1, 0), NewComplex64(1, 0)}
+	roots, err := p.Roots()
+	if err != ErrNoConvergence {
+		t.Errorf("want ErrNoConvergence, have %v", err)
+	}
+	if len(roots) != 2 {
+		t.Errorf("want 2 approximations, have %d", len(roots))
+	}
+}
+
+func ttToBuiltinPoly(p Poly64) []complex64 {
+	res := make([]complex64, len(p))
+	for i, x := range p {
+		res[i] = complex(x.r, x.i)
+	}
+	return res
+}
+
+func ttAbsPoly(p Poly64) []float64 {
+	abs := make([]float64, len(p))
+	for i, a := range p {
+		abs[i] = float64(a.Abs())
+	}
+	return abs
+}
+
+// ttSame64 reports whether x and y parts are equal or both are NaN.
+func ttSame64(x complex64, y Complex64) bool {
+	same := func(a, b float32) bool {
+		return a == b || (a != a && b != b)
+	}
+	return same(real(x), y.r) && same(imag(x), y.i)
+}
+
+// Performance tests.
+
+func BenchmarkPolyEval64Builtin(b *testing.B) {
+	p := ttToBuiltinPoly(ttRandomPoly(1, 16))
+	benchBuiltin(b.N, func(x, y complex64) {
+		v, d := ttPolyEvalDerivBuiltin(p, x)
+		ttReal32 = real(v)
+		ttImag32 = imag(d)
+	})
+}
+
+func BenchmarkPolyEval64(b *testing.B) {
+	p := ttRandomPoly(1, 16)
+	bench(b.N, func(x, y Complex64) {
+		v, d := p.EvalDeriv(x)
+		ttReal32 = v.Real()
+		ttImag32 = d.Imag()
+	})
+}
+
+func BenchmarkPolyRoots64Builtin(b *testing.B) {
+	for _, n := range []int{4, 16, 64} {
+		p := ttToBuiltinPoly(ttPolyFromRoots(ttPolyBenchRoots(n)))
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ttPolyRootsBuiltin(p)
+			}
+		})
+	}
+}
+
+func BenchmarkPolyRoots64(b *testing.B) {
+	for _, n := range []int{4, 16, 64} {
+		p := ttPolyFromRoots(ttPolyBenchRoots(n))
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.Roots()
+			}
+		})
+	}
+}
+
+// ttPolyBenchRoots returns n roots inside the unit disk.
+func ttPolyBenchRoots(n int) []complex64 {
+	roots := make([]complex64, n)
+	for k := range roots {
+		roots[k] = complex64(cmplx.Rect(0.5+0.5*float64(k)/float64(n), float64(k)))
+	}
+	return roots
+}
@@ -0,0 +1,281 @@
+package xmath
+
+import "math"
+
+// Quat64 is a quaternion w + x*i + y*j + z*k with float32 parts.
+//
+// Like Complex64, this type has value semantics.
+// Add and Sub round every part of the result once;
+// Mul computes it with float64 precision before rounding.
+// Other operations are computed by Quat128 and
+// their results are converted back to float32.
+type Quat64 struct {
+	w, x, y, z float32
+}
+
+// Quat128 is a quaternion w + x*i + y*j + z*k with float64 parts.
+type Quat128 struct {
+	w, x, y, z float64
+}
+
+// NewQuat64 returns w + x*i + y*j + z*k quaternion.
+func NewQuat64(w, x, y, z float32) Quat64 {
+	return Quat64{w: w, x: x, y: y, z: z}
+}
+
+// NewQuat128 returns w + x*i + y*j + z*k quaternion.
+func NewQuat128(w, x, y, z float64) Quat128 {
+	return Quat128{w: w, x: x, y: y, z: z}
+}
+
+// NewQuat64AxisAngle returns a unit quaternion that rotates
+// vectors by angle radians around axis (right-hand rule).
+// axis doesn't have to be normalized; zero axis gives identity rotation.
+func NewQuat64AxisAngle(axis [3]float32, angle float32) Quat64 {
+	v := [3]float64{float64(axis[0]), float64(axis[1]), float64(axis[2])}
+	return NewQuat128AxisAngle(v, float64(angle)).Quat64()
+}
+
+// NewQuat128AxisAngle returns a unit quaternion that rotates
+// vectors by angle radians around axis.
+// See NewQuat64AxisAngle for details.
+func NewQuat128AxisAngle(axis [3]float64, angle float64) Quat128 {
+	n := math.Sqrt(axis[0]*axis[0] + axis[1]*axis[1] + axis[2]*axis[2])
+	if n == 0 {
+		return Quat128{w: 1}
+	}
+	s, c := math.Sincos(angle / 2)
+	s /= n
+	return Quat128{w: c, x: axis[0] * s, y: axis[1] * s, z: axis[2] * s}
+}
+
+// NewQuat64Euler returns a unit quaternion for roll, pitch and yaw
+// angles in radians (rotations around x, y and z axes).
+// Rotations are applied in the roll, pitch, yaw order,
+// so the result is yaw * pitch * roll.
+func NewQuat64Euler(roll, pitch, yaw float32) Quat64 {
+	return NewQuat128Euler(float64(roll), float64(pitch), float64(yaw)).Quat64()
+}
+
+// NewQuat128Euler returns a unit quaternion for roll, pitch and yaw angles.
+// See NewQuat64Euler for details.
+func NewQuat128Euler(roll, pitch, yaw float64) Quat128 {
+	sr, cr := math.Sincos(roll / 2)
+	sp, cp := math.Sincos(pitch / 2)
+	sy, cy := math.Sincos(yaw / 2)
+	return Quat128{
+		w: cr*cp*cy + sr*sp*sy,
+		x: sr*cp*cy - cr*sp*sy,
+		y: cr*sp*cy + sr*cp*sy,
+		z: cr*cp*sy - sr*sp*cy,
+	}
+}
+
+// Quat128 converts q to Quat128.
+// The conversion is exact.
+func (q Quat64) Quat128() Quat128 {
+	return Quat128{w: float64(q.w), x: float64(q.x), y: float64(q.y), z: float64(q.z)}
+}
+
+// Quat64 converts q to Quat64.
+// Every part is rounded to the nearest float32 value.
+func (q Quat128) Quat64() Quat64 {
+	return Quat64{w: float32(q.w), x: float32(q.x), y: float32(q.y), z: float32(q.z)}
+}
+
+// Parts returns quaternion real part w and i, j, k coefficients.
+func (q Quat64) Parts() (w, x, y, z float32) { return q.w, q.x, q.y, q.z }
+
+// Parts returns quaternion real part w and i, j, k coefficients.
+func (q Quat128) Parts() (w, x, y, z float64) { return q.w, q.x, q.y, q.z }
+
+// IsZero returns true if all q parts are 0.
+func (q Quat64) IsZero() bool {
+	return q == Quat64{}
+}
+
+// IsZero returns true if all q parts are 0.
+func (q Quat128) IsZero() bool {
+	return q == Quat128{}
+}
+
+// Eq is "==" operation.
+func (q Quat64) Eq(p Quat64) bool {
+	return q == p
+}
+
+// Eq is "==" operation.
+func (q Quat128) Eq(p Quat128) bool {
+	return q == p
+}
+
+// Add is "+" operation.
+func (q Quat64) Add(p Quat64) Quat64 {
+	return Quat64{w: q.w + p.w, x: q.x + p.x, y: q.y + p.y, z: q.z + p.z}
+}
+
+// Add is "+" operation.
+func (q Quat128) Add(p Quat128) Quat128 {
+	return Quat128{w: q.w + p.w, x: q.x + p.x, y: q.y + p.y, z: q.z + p.z}
+}
+
+// Sub is "-" operation.
+func (q Quat64) Sub(p Quat64) Quat64 {
+	return Quat64{w: q.w - p.w, x: q.x - p.x, y: q.y - p.y, z: q.z - p.z}
+}
+
+// Sub is "-" operation.
+func (q Quat128) Sub(p Quat128) Quat128 {
+	return Quat128{w: q.w - p.w, x: q.x - p.x, y: q.y - p.y, z: q.z - p.z}
+}
+
+// Conj returns the conjugate of q.
+func (q Quat64) Conj() Quat64 {
+	return Quat64{w: q.w, x: -q.x, y: -q.y, z: -q.z}
+}
+
+// Conj returns the conjugate of q.
+func (q Quat128) Conj() Quat128 {
+	return Quat128{w: q.w, x: -q.x, y: -q.y, z: -q.z}
+}
+
+// Mul is quaternion (Hamilton) product q*p.
+// Unlike complex multiplication, it's not commutative.
+func (q Quat64) Mul(p Quat64) Quat64 { return q.Quat128().Mul(p.Quat128()).Quat64() }
+
+// Mul is quaternion (Hamilton) product q*p.
+func (q Quat128) Mul(p Quat128) Quat128 {
+	return Quat128{
+		w: q.w*p.w - q.x*p.x - q.y*p.y - q.z*p.z,
+		x: q.w*p.x + q.x*p.w + q.y*p.z - q.z*p.y,
+		y: q.w*p.y - q.x*p.z + q.y*p.w + q.z*p.x,
+		z: q.w*p.z + q.x*p.y - q.y*p.x + q.z*p.w,
+	}
+}
+
+// Div is right division q * p^-1.
+func (q Quat64) Div(p Quat64) Quat64 { return q.Quat128().Div(p.Quat128()).Quat64() }
+
+// Div is right division q * p^-1.
+func (q Quat128) Div(p Quat128) Quat128 {
+	return q.Mul(p.Inverse())
+}
+
+// Norm returns the Euclidean norm (the absolute value) of q.
+func (q Quat64) Norm() float32 { return float32(q.Quat128().Norm()) }
+
+// Norm returns the Euclidean norm (the absolute value) of q.
+func (q Quat128) Norm() float64 {
+	return math.Hypot(math.Hypot(q.w, q.x), math.Hypot(q.y, q.z))
+}
+
+// Inverse returns q^-1, such that q * q^-1 = 1.
+func (q Quat64) Inverse() Quat64 { return q.Quat128().Inverse().Quat64() }
+
+// Inverse returns q^-1, such that q * q^-1 = 1.
+func (q Quat128) Inverse() Quat128 {
+	n := q.w*q.w + q.x*q.x + q.y*q.y + q.z*q.z
+	return Quat128{w: q.w / n, x: -q.x / n, y: -q.y / n, z: -q.z / n}
+}
+
+// Unit returns q divided by its norm.
+func (q Quat64) Unit() Quat64 { return q.Quat128().Unit().Quat64() }
+
+// Unit returns q divided by its norm.
+func (q Quat128) Unit() Quat128 {
+	return q.scale(1 / q.Norm())
+}
+
+// Rotate returns v rotated by unit quaternion q: q * v * conj(q).
+func (q Quat64) Rotate(v [3]float32) [3]float32 {
+	r := q.Quat128().Rotate([3]float64{float64(v[0]), float64(v[1]), float64(v[2])})
+	return [3]float32{float32(r[0]), float32(r[1]), float32(r[2])}
+}
+
+// Rotate returns v rotated by unit quaternion q: q * v * conj(q).
+func (q Quat128) Rotate(v [3]float64) [3]float64 {
+	// v + w*t + cross(u, t), where t = 2*cross(u, v),
+	// is cheaper than two quaternion products.
+	u := [3]float64{q.x, q.y, q.z}
+	t := cross(u, v)
+	for i := range t {
+		t[i] *= 2
+	}
+	c := cross(u, t)
+	for i := range v {
+		v[i] += q.w*t[i] + c[i]
+	}
+	return v
+}
+
+// Exp returns e**q.
+func (q Quat64) Exp() Quat64 { return q.Quat128().Exp().Quat64() }
+
+// Exp returns e**q.
+func (q Quat128) Exp() Quat128 {
+	e := math.Exp(q.w)
+	theta := math.Sqrt(q.x*q.x + q.y*q.y + q.z*q.z)
+	if theta == 0 {
+		return Quat128{w: e}
+	}
+	s, c := math.Sincos(theta)
+	s *= e / theta
+	return Quat128{w: e * c, x: q.x * s, y: q.y * s, z: q.z * s}
+}
+
+// Log returns the natural logarithm of q.
+//
+// For negative real q the result is log|q| + π*i,
+// like for complex numbers.
+func (q Quat64) Log() Quat64 { return q.Quat128().Log().Quat64() }
+
+// Log returns the natural logarithm of q.
+// See Quat64.Log for details.
+func (q Quat128) Log() Quat128 {
+	vn := math.Sqrt(q.x*q.x + q.y*q.y + q.z*q.z)
+	l := math.Log(q.Norm())
+	theta := math.Atan2(vn, q.w)
+	if vn == 0 {
+		return Quat128{w: l, x: theta}
+	}
+	s := theta / vn
+	return Quat128{w: l, x: q.x * s, y: q.y * s, z: q.z * s}
+}
+
+// Slerp returns spherical linear interpolation between unit
+// quaternions a and b; t=0 gives a and t=1 gives b (or -b).
+// The shortest of two possible arcs is used.
+func Slerp(a, b Quat64, t float32) Quat64 {
+	return Slerp128(a.Quat128(), b.Quat128(), float64(t)).Quat64()
+}
+
+// Slerp128 returns spherical linear interpolation between unit
+// quaternions a and b. See Slerp for details.
+func Slerp128(a, b Quat128, t float64) Quat128 {
+	dot := a.w*b.w + a.x*b.x + a.y*b.y + a.z*b.z
+	if dot < 0 {
+		// q and -q represent the same rotation.
+		b = b.scale(-1)
+		dot = -dot
+	}
+	if dot > 0.9995 {
+		// sin(theta) is too small for the division,
+		// linear interpolation is accurate enough.
+		return a.scale(1 - t).Add(b.scale(t)).Unit()
+	}
+	theta := math.Acos(dot)
+	s := math.Sin(theta)
+	return a.scale(math.Sin((1-t)*theta) / s).Add(b.scale(math.Sin(t*theta) / s))
+}
+
+func (q Quat128) scale(s float64) Quat128 {
+	return Quat128{w: q.w * s, x: q.x * s, y: q.y * s, z: q.z * s}
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
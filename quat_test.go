@@ -0,0 +1,267 @@
+package xmath
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttQuat64Builtin is a quaternion a + b*j built from two complex
+// numbers (Cayley–Dickson construction), the closest thing to
+// builtin quaternions Go has.
+type ttQuat64Builtin struct {
+	a, b complex64
+}
+
+func (q ttQuat64Builtin) mul(p ttQuat64Builtin) ttQuat64Builtin {
+	conj := func(c complex64) complex64 { return complex(real(c), -imag(c)) }
+	return ttQuat64Builtin{
+		a: q.a*p.a - q.b*conj(p.b),
+		b: q.a*p.b + q.b*conj(p.a),
+	}
+}
+
+// ttQuat128Builtin is ttQuat64Builtin with complex128 parts.
+type ttQuat128Builtin struct {
+	a, b complex128
+}
+
+func (q ttQuat128Builtin) mul(p ttQuat128Builtin) ttQuat128Builtin {
+	return ttQuat128Builtin{
+		a: q.a*p.a - q.b*cmplx.Conj(p.b),
+		b: q.a*p.b + q.b*cmplx.Conj(p.a),
+	}
+}
+
+func ttRandomQuats(seed uint64, n int) []Quat128 {
+	rng := rand.New(rand.NewPCG(45, seed))
+	qs := make([]Quat128, n)
+	for i := range qs {
+		qs[i] = NewQuat128(rng.NormFloat64(), rng.NormFloat64(), rng.NormFloat64(), rng.NormFloat64())
+	}
+	return qs
+}
+
+// ttQuatDist returns the largest part-wise difference of p and q.
+func ttQuatDist(p, q Quat128) float64 {
+	d := p.Sub(q)
+	return math.Max(math.Max(math.Abs(d.w), math.Abs(d.x)), math.Max(math.Abs(d.y), math.Abs(d.z)))
+}
+
+func ttVecDist(a, b [3]float64) float64 {
+	return math.Max(math.Abs(a[0]-b[0]), math.Max(math.Abs(a[1]-b[1]), math.Abs(a[2]-b[2])))
+}
+
+// Unit tests.
+
+func TestQuatBasis(t *testing.T) {
+	one := NewQuat64(1, 0, 0, 0)
+	i := NewQuat64(0, 1, 0, 0)
+	j := NewQuat64(0, 0, 1, 0)
+	k := NewQuat64(0, 0, 0, 1)
+	minusOne := NewQuat64(-1, 0, 0, 0)
+
+	tests := []struct {
+		name string
+		have Quat64
+		want Quat64
+	}{
+		{"i*i", i.Mul(i), minusOne},
+		{"j*j", j.Mul(j), minusOne},
+		{"k*k", k.Mul(k), minusOne},
+		{"i*j*k", i.Mul(j).Mul(k), minusOne},
+		{"i*j", i.Mul(j), k},
+		{"j*i", j.Mul(i), k.Conj()},
+		{"j*k", j.Mul(k), i},
+		{"k*i", k.Mul(i), j},
+		{"1/i", one.Div(i), i.Conj()},
+		{"i^-1", i.Inverse(), i.Conj()},
+	}
+	for _, tt := range tests {
+		if !tt.have.Eq(tt.want) {
+			t.Errorf("%s failed;\nwant: %v\nhave: %v", tt.name, tt.want, tt.have)
+		}
+	}
+}
+
+func TestQuatIdentities(t *testing.T) {
+	const eps = 1e-13
+	qs := ttRandomQuats(0, 200)
+	one := NewQuat128(1, 0, 0, 0)
+	for n := range qs {
+		p, q, r := qs[n], qs[(n+1)%len(qs)], qs[(n+2)%len(qs)]
+		tests := []struct {
+			name string
+			have Quat128
+			want Quat128
+		}{
+			{"(pq)r = p(qr)", p.Mul(q).Mul(r), p.Mul(q.Mul(r))},
+			{"p(q+r) = pq+pr", p.Mul(q.Add(r)), p.Mul(q).Add(p.Mul(r))},
+			{"conj(pq) = conj(q)conj(p)", p.Mul(q).Conj(), q.Conj().Mul(p.Conj())},
+			{"p*p^-1 = 1", p.Mul(p.Inverse()), one},
+			{"p^-1*p = 1", p.Inverse().Mul(p), one},
+			{"(p/q)*q = p", p.Div(q).Mul(q), p},
+			{"exp(log(p)) = p", p.Log().Exp(), p},
+			{"exp(p)exp(-p) = 1", p.Exp().Mul(p.scale(-1).Exp()), one},
+		}
+		for _, tt := range tests {
+			scale := math.Max(1, tt.want.Norm())
+			if d := ttQuatDist(tt.have, tt.want); d > eps*scale {
+				t.Errorf("%s for p=%v, q=%v, r=%v failed;\nwant: %v\nhave: %v", tt.name, p, q, r, tt.want, tt.have)
+			}
+		}
+
+		if have, want := p.Mul(q).Norm(), p.Norm()*q.Norm(); math.Abs(have-want) > eps*want {
+			t.Errorf("|pq| = |p||q| failed;\nwant: %v\nhave: %v", want, have)
+		}
+
+		// The Cayley–Dickson construction gives the same product.
+		pb := ttQuat128Builtin{a: complex(p.w, p.x), b: complex(p.y, p.z)}
+		qb := ttQuat128Builtin{a: complex(q.w, q.x), b: complex(q.y, q.z)}
+		prod := pb.mul(qb)
+		want := NewQuat128(real(prod.a), imag(prod.a), real(prod.b), imag(prod.b))
+		if d := ttQuatDist(p.Mul(q), want); d > eps*want.Norm() {
+			t.Errorf("%v*%v differs from Cayley–Dickson product;\nwant: %v\nhave: %v", p, q, want, p.Mul(q))
+		}
+
+		// Quat64 results are Quat128 results rounded once.
+		p64, q64 := p.Quat64(), q.Quat64()
+		if have, want := p64.Mul(q64), p64.Quat128().Mul(q64.Quat128()).Quat64(); have != want {
+			t.Errorf("Quat64 %v*%v failed;\nwant: %v\nhave: %v", p64, q64, want, have)
+		}
+	}
+
+	if have, want := NewQuat128(-2, 0, 0, 0).Log(), NewQuat128(math.Ln2, math.Pi, 0, 0); have != want {
+		t.Errorf("log(-2) failed;\nwant: %v\nhave: %v", want, have)
+	}
+}
+
+func TestQuatRotation(t *testing.T) {
+	const eps = 1e-15
+	x := [3]float64{1, 0, 0}
+	y := [3]float64{0, 1, 0}
+	z := [3]float64{0, 0, 1}
+
+	tests := []struct {
+		name string
+		q    Quat128
+		v    [3]float64
+		want [3]float64
+	}{
+		{"z 90°", NewQuat128AxisAngle(z, math.Pi/2), x, y},
+		{"x 90°", NewQuat128AxisAngle(x, math.Pi/2), y, z},
+		{"y 90°", NewQuat128AxisAngle([3]float64{0, 5, 0}, math.Pi/2), z, x},
+		{"z 180°", NewQuat128AxisAngle(z, math.Pi), y, [3]float64{0, -1, 0}},
+		{"diagonal 120°", NewQuat128AxisAngle([3]float64{1, 1, 1}, 2*math.Pi/3), x, y},
+		{"zero axis", NewQuat128AxisAngle([3]float64{}, 1), x, x},
+		{"yaw", NewQuat128Euler(0, 0, math.Pi/2), x, y},
+		{"roll", NewQuat128Euler(math.Pi/2, 0, 0), y, z},
+		{"pitch", NewQuat128Euler(0, math.Pi/2, 0), z, x},
+		// Roll is applied first, yaw is applied last.
+		{"roll, yaw", NewQuat128Euler(math.Pi/2, 0, math.Pi/2), y, z},
+		{"pitch, yaw", NewQuat128Euler(0, math.Pi/2, math.Pi/2), z, y},
+	}
+	for _, tt := range tests {
+		if have := tt.q.Rotate(tt.v); ttVecDist(have, tt.want) > 4*eps {
+			t.Errorf("%s: rotation of %v failed;\nwant: %v\nhave: %v", tt.name, tt.v, tt.want, have)
+		}
+	}
+
+	// Rotation is q*v*conj(q) and it preserves vector lengths.
+	for _, q := range ttRandomQuats(1, 100) {
+		q = q.Unit()
+		v := [3]float64{q.z, -q.w, 2}
+		have := q.Rotate(v)
+		p := q.Mul(NewQuat128(0, v[0], v[1], v[2])).Mul(q.Conj())
+		if want := [3]float64{p.x, p.y, p.z}; ttVecDist(have, want) > 1e-14 {
+			t.Errorf("%v: rotation of %v failed;\nwant: %v\nhave: %v", q, v, want, have)
+		}
+		if d := math.Abs(math.Hypot(math.Hypot(have[0], have[1]), have[2]) - math.Hypot(math.Hypot(v[0], v[1]), v[2])); d > 1e-14 {
+			t.Errorf("%v: rotation of %v changed the length by %v", q, v, d)
+		}
+	}
+
+	q := NewQuat64AxisAngle([3]float32{0, 0, 2}, math.Pi/2)
+	have := q.Rotate([3]float32{2, 0, 0})
+	if d := ttVecDist([3]float64{float64(have[0]), float64(have[1]), float64(have[2])}, [3]float64{0, 2, 0}); d > 1e-6 {
+		t.Errorf("Quat64 rotation failed: %v", have)
+	}
+	if q != NewQuat64Euler(0, 0, math.Pi/2) {
+		t.Errorf("Quat64 axis-angle and Euler constructors mismatch")
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	const eps = 1e-14
+	z := [3]float64{0, 0, 1}
+	a := NewQuat128AxisAngle(z, 0.5)
+	b := NewQuat128AxisAngle(z, 2.5)
+
+	tests := []struct {
+		name string
+		have Quat128
+		want Quat128
+	}{
+		{"t=0", Slerp128(a, b, 0), a},
+		{"t=1", Slerp128(a, b, 1), b},
+		{"t=0.25", Slerp128(a, b, 0.25), NewQuat128AxisAngle(z, 1)},
+		{"t=0.5", Slerp128(a, b, 0.5), NewQuat128AxisAngle(z, 1.5)},
+		// -b is the same rotation as b, the shortest arc is taken.
+		{"t=0.5, -b", Slerp128(a, b.scale(-1), 0.5), NewQuat128AxisAngle(z, 1.5)},
+		{"t=0.5, a~b", Slerp128(a, NewQuat128AxisAngle(z, 0.5+1e-6), 0.5), NewQuat128AxisAngle(z, 0.5+0.5e-6)},
+	}
+	for _, tt := range tests {
+		if d := ttQuatDist(tt.have, tt.want); d > eps {
+			t.Errorf("slerp %s failed;\nwant: %v\nhave: %v", tt.name, tt.want, tt.have)
+		}
+	}
+
+	if have, want := Slerp(a.Quat64(), b.Quat64(), 0.5), NewQuat128AxisAngle(z, 1.5).Quat64(); ttQuatDist(have.Quat128(), want.Quat128()) > 1e-7 {
+		t.Errorf("Quat64 slerp failed;\nwant: %v\nhave: %v", want, have)
+	}
+}
+
+// Performance tests.
+
+var (
+	ttQuat64  Quat64
+	ttQuat128 Quat128
+
+	ttQuatAcc64  ttQuat64Builtin
+	ttQuatAcc128 ttQuat128Builtin
+)
+
+func BenchmarkMulQuat64Builtin(b *testing.B) {
+	benchBuiltin(b.N, func(x, y complex64) {
+		q := ttQuat64Builtin{a: x, b: y}
+		p := ttQuat64Builtin{a: y, b: x}
+		ttQuatAcc64 = q.mul(p).mul(q).mul(p)
+	})
+}
+
+func BenchmarkMulQuat64(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		q := NewQuat64(x.r, x.i, y.r, y.i)
+		p := NewQuat64(y.r, y.i, x.r, x.i)
+		ttQuat64 = q.Mul(p).Mul(q).Mul(p)
+	})
+}
+
+func BenchmarkMulQuat128Builtin(b *testing.B) {
+	benchBuiltin128(b.N, func(x, y complex128) {
+		q := ttQuat128Builtin{a: x, b: y}
+		p := ttQuat128Builtin{a: y, b: x}
+		ttQuatAcc128 = q.mul(p).mul(q).mul(p)
+	})
+}
+
+func BenchmarkMulQuat128(b *testing.B) {
+	bench128(b.N, func(x, y Complex128) {
+		q := NewQuat128(x.r, x.i, y.r, y.i)
+		p := NewQuat128(y.r, y.i, x.r, x.i)
+		ttQuat128 = q.Mul(p).Mul(q).Mul(p)
+	})
+}
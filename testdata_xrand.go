@@ -0,0 +1,600 @@
+// Code generated by gen_testdata.go; DO NOT EDIT.
+
+package xmath
+
+import "math"
+
+// ttXrandValues are generated counterparts of ttValues,
+// including special values that ttValues lack.
+var ttXrandValues = []ttValueSet{
+	{8.524487e+06, 5.817055e+06, 292834.03, 6.2774555e+06},
+	{7.7407325e+06, 5.8721305e+06, 4.653416e+06, 5.1351235e+06},
+	{903858.56, 7.734736e+06, 5.2171575e+06, 8.519042e+06},
+	{8.784409e+06, 9.32414e+06, 4.099421e+06, 2.3181838e+06},
+	{32554.17, 4.928552e+06, 4.6620955e+06, 6.2636225e+06},
+	{8.742842e+06, 3.6136338e+06, 5.9541705e+06, 3.0509368e+06},
+	{6.945114e+06, 6.8514955e+06, 2.991587e+06, 9.670506e+06},
+	{5.492144e+06, 2.5452232e+06, 6.264655e+06, 6.2678975e+06},
+	{6.038866e+06, 786735.06, 5.3315525e+06, 1.0214046e+06},
+	{4.280701e+06, 7.914513e+06, 1.3279979e+06, 9.221377e+06},
+	{1.9186482e+06, 626582.2, 2.32219e+06, 9.128632e+06},
+	{1.6921742e+06, 2.635495e+06, 6.9617515e+06, 4.6985155e+06},
+	{4.4053485e+06, 9.873434e+06, 5.3852225e+06, 1.826775e+06},
+	{2.6342212e+06, 3.5078065e+06, 713677.25, 7.21516e+06},
+	{6.8468935e+06, 7.091328e+06, 4.2466525e+06, 4.2281355e+06},
+	{3.5670658e+06, 8.2025855e+06, 390779.06, 2.9381118e+06},
+	{1.7884096e+06, 1.0853492e+06, 2.2556995e+06, 4.5476275e+06},
+	{9.677548e+06, 5.559608e+06, 5.27408e+06, 2.708132e+06},
+	{9.756681e+06, 8.601666e+06, 8.984737e+06, 28664.678},
+	{5.4934975e+06, 8.558865e+06, 2.6250375e+06, 7.417823e+06},
+	{2.3370572e+06, 4.7675665e+06, 5.4734605e+06, 2.4450002e+06},
+	{4.830208e+06, 962361, 314411.88, 6.885985e+06},
+	{6.750373e+06, 3.8755512e+06, 6.616803e+06, 3.9424798e+06},
+	{3.3308905e+06, 1.4135956e+06, 8.273767e+06, 905968.94},
+	{2.557294e+06, 8.601519e+06, 1.442013e+06, 5.6501375e+06},
+	{1.4207482e+06, 1.0671058e+06, 4.961214e+06, 288779.25},
+	{1.6388662e+06, 8.29022e+06, 5.767667e+06, 8.610288e+06},
+	{6.5643185e+06, 6.0047275e+06, 3.4955268e+06, 7.9400375e+06},
+	{3.5140388e+06, 3.1013502e+06, 1.2810009e+06, 3.7965932e+06},
+	{602200.1, 9.365287e+06, 5.655617e+06, 1.3117538e+06},
+	{4.5777125e+06, 6.6551135e+06, 6.340259e+06, 8.838571e+06},
+	{2.891128e+06, 5.118707e+06, 6.554958e+06, 5.264309e+06},
+	{5.0114525e+06, 2.4047818e+06, 2.3029482e+06, 3.9959928e+06},
+	{761821.5, 1.5996206e+06, 6.694935e+06, 7.008174e+06},
+	{6.512731e+06, 8.826054e+06, 522298.7, 9.457835e+06},
+	{4.422847e+06, 3.9903042e+06, 8.971863e+06, 5.491108e+06},
+	{47705.645, 4.919837e+06, 2.1212115e+06, 6.9887795e+06},
+	{8.825993e+06, 7.5918215e+06, 289981.34, 1.9655349e+06},
+	{9.650083e+06, 9.543471e+06, 1.1377984e+06, 3.2881395e+06},
+	{5.969507e+06, 6.558705e+06, 387540.75, 1.0901376e+06},
+	{1.6264355e+06, 64618.117, 7.0237255e+06, 2.5550308e+06},
+	{762858.44, 9.523276e+06, 3.9142018e+06, 229665.03},
+	{3.788795e+06, 4.1254898e+06, 1.6296442e+06, 2.2702025e+06},
+	{3.7501405e+06, 408879.56, 9.910598e+06, 8.2683955e+06},
+	{6.3772215e+06, 4.510474e+06, 3.5046872e+06, 8.411755e+06},
+	{1.1545604e+06, 5.1763805e+06, 6.111873e+06, 9.122383e+06},
+	{4.674677e+06, 2.2102655e+06, 3.8221918e+06, 703884.9},
+	{478475.84, 8.450415e+06, 4.6535695e+06, 9.088856e+06},
+	{5.307322e+06, 3.1780675e+06, 736692.8, 60813.79},
+	{7.4752235e+06, 1.8009104e+06, 3.044156e+06, 8.411377e+06},
+	{4.410543e+06, 3.2968125e+06, 5.4090365e+06, 7.8058865e+06},
+	{3.6001668e+06, 3.0393112e+06, 1.8916261e+06, 6.2316005e+06},
+	{6.6876055e+06, 1.3107246e+06, 8.64791e+06, 6.739692e+06},
+	{3.7558868e+06, 7.003935e+06, 9.688163e+06, 4.787045e+06},
+	{8.679014e+06, 3.7896675e+06, 6.983424e+06, 947458.1},
+	{7.2470625e+06, 9.116673e+06, 4.872536e+06, 6.6987165e+06},
+	{6.866402e+06, 2.2700562e+06, 2.7807635e+06, 2.9841148e+06},
+	{768395.06, 3.3477435e+06, 6.548081e+06, 857889.4},
+	{6.274663e+06, 3.9554998e+06, 8.134926e+06, 5.3935125e+06},
+	{1.4367848e+06, 3.2940148e+06, 2.2539062e+06, 6.39373e+06},
+	{9.024521e+06, 259834.92, 6.9518435e+06, 8.2790135e+06},
+	{8.163244e+06, 8.0621135e+06, 8.3847955e+06, 6.183334e+06},
+	{2.30548e+06, 8.833547e+06, 9.086648e+06, 5.179878e+06},
+	{7.061276e+06, 8.195804e+06, 4.0836732e+06, 8.3001095e+06},
+	{5.744565e+06, 6.830028e+06, 804505.75, 4.279241e+06},
+	{1.2529539e+06, 4.697047e+06, 4.630361e+06, 3.5026085e+06},
+	{8.533353e+06, 7.5219745e+06, 376106.16, 8.670633e+06},
+	{8.667078e+06, 3.8902208e+06, 6.398948e+06, 1.279508e+06},
+	{3.0375415e+06, 7.1026745e+06, 70075.56, 328699.1},
+	{9.252428e+06, 9.64417e+06, 5.689672e+06, 2.7739522e+06},
+	{5.9705455e+06, 9.391609e+06, 4.6309325e+06, 5.6975965e+06},
+	{2.1086658e+06, 7.7313095e+06, 9.445056e+06, 6.8754075e+06},
+	{284722, 9.543965e+06, 2.7159132e+06, 8.722236e+06},
+	{4.521598e+06, 7.4513675e+06, 9.234259e+06, 6.7647165e+06},
+	{4.574208e+06, 7.252539e+06, 626475.25, 4.613277e+06},
+	{6.787078e+06, 5.883749e+06, 4.314116e+06, 2.8678272e+06},
+	{5.4637815e+06, 6.7983995e+06, 3.3475318e+06, 2.8210742e+06},
+	{7.6302465e+06, 1.4616425e+06, 289560.4, 4.2948995e+06},
+	{546113.1, 7.4011595e+06, 6.464907e+06, 5.5956095e+06},
+	{6.46278e+06, 2.4868545e+06, 802975.7, 4.7262445e+06},
+	{5.6357265e+06, 3.417285e+06, 914253.5, 5.6127475e+06},
+	{4.8974405e+06, 5.645298e+06, 6.351637e+06, 928125.2},
+	{3.408654e+06, 9.201338e+06, 8.432577e+06, 9.844321e+06},
+	{4.0470468e+06, 6.165586e+06, 9.621631e+06, 311591.56},
+	{9.370791e+06, 4.766919e+06, 4.835023e+06, 9.595903e+06},
+	{7.6527645e+06, 5.5023955e+06, 8.09476e+06, 5.5700085e+06},
+	{896985.94, 617209.75, 1.0835559e+06, 8.254906e+06},
+	{6.091328e+06, 9.596887e+06, 844867.9, 3.4895812e+06},
+	{9.535151e+06, 3.7200832e+06, 6.0485775e+06, 8.348177e+06},
+	{622535.44, 9.273035e+06, 2.44972e+06, 7.701007e+06},
+	{5.3320625e+06, 3.1028005e+06, 4.3994935e+06, 5.7971155e+06},
+	{7.5536705e+06, 7.6213965e+06, 5.755069e+06, 8.0065595e+06},
+	{8.597586e+06, 1.909525e+06, 7.631959e+06, 629333.44},
+	{6.2225905e+06, 9.513595e+06, 7.3800265e+06, 5.284365e+06},
+	{5.356208e+06, 9.46374e+06, 5.573785e+06, 8.092505e+06},
+	{3.5525015e+06, 6.2389485e+06, 4.797037e+06, 3.4490488e+06},
+	{3.1476988e+06, 971272.3, 7.9514755e+06, 7.445486e+06},
+	{642302.4, 4.967153e+06, 5.943641e+06, 3.4404898e+06},
+	{3.7951915e+06, 2.619444e+06, 8.629475e+06, 7.6394385e+06},
+	{4.505668e+06, 4.298787e+06, 5.5326015e+06, 1.2138868e+06},
+	{99309.32, 241.54422, 8254.656, 3.1377335e+06},
+	{74414.06, 522.44336, 4826.8813, 775229.5},
+	{83551.8, 662.499, 3331.225, 6.9277255e+06},
+	{20457.459, 65.822105, 6914.026, 7.567878e+06},
+	{7946.2334, 271.61987, 5420.1987, 6.183397e+06},
+	{88615.37, 741.18976, 6928.2246, 2.3594078e+06},
+	{82569.555, 931.1175, 7947.7256, 5.910881e+06},
+	{65105.98, 375.8656, 2339.093, 3.890616e+06},
+	{20141.283, 599.33734, 5879.93, 3.5948628e+06},
+	{94331.91, 837.4749, 7029.422, 6.6212785e+06},
+	{74179.49, 887.1147, 2203.6455, 6.313204e+06},
+	{56378.812, 364.9325, 2034.6403, 1.2055188e+06},
+	{54222.125, 111.35858, 3004.4578, 7.4898205e+06},
+	{46568.78, 614.7056, 7741.5864, 2.615222e+06},
+	{97805.516, 104.85226, 6448.1167, 4.2022355e+06},
+	{33055.03, 520.933, 5597.6313, 3.1799398e+06},
+	{93679.31, 179.07191, 8420.785, 1.7381544e+06},
+	{41738.066, 343.48453, 1951.968, 3.3429632e+06},
+	{62915.184, 992.77277, 5581.9673, 9.006831e+06},
+	{9402.676, 403.8613, 1319.1185, 6.477891e+06},
+	{59620.074, 2.8868916, 1683.6935, 4.8633545e+06},
+	{9855.649, 207.82013, 2001.6692, 7.4279955e+06},
+	{29688.078, 635.4111, 1744.4764, 9.656252e+06},
+	{78270.43, 376.19647, 839.14355, 9.634198e+06},
+	{4864.942, 261.60217, 4066.8088, 9.256109e+06},
+	{88284.99, 433.43402, 2611.7883, 7.792808e+06},
+	{18314.576, 209.68597, 7860.55, 5.2494065e+06},
+	{8781.398, 265.15283, 164.10297, 6.1303175e+06},
+	{28950.133, 746.58936, 8740.265, 4.9953915e+06},
+	{42001.344, 504.68442, 1326.4362, 3.32904e+06},
+	{26138.2, 262.82007, 7160.9385, 823866.9},
+	{57386.277, 355.82523, 7645.5522, 5.533036e+06},
+	{4129.3784, 895.9418, 8962.291, 9.981708e+06},
+	{53543.96, 203.43156, 386.16122, 606301.3},
+	{8926.765, 819.1163, 4361.59, 4.901587e+06},
+	{87238.055, 394.40118, 636.9227, 1.3756828e+06},
+	{36724.25, 13.468038, 9363.143, 562695.75},
+	{10743.985, 261.83127, 7020.857, 5.6430945e+06},
+	{14431.982, 650.22235, 3521.9766, 7.00388e+06},
+	{58662.78, 660.6696, 9379.518, 4.0601682e+06},
+	{71377.62, 672.2265, 2235.6797, 4.723722e+06},
+	{66397.24, 71.65137, 8082.389, 9.00434e+06},
+	{13379.256, 421.07394, 6962.373, 768711.06},
+	{86183.164, 609.8075, 771.27155, 3.806411e+06},
+	{481.2688, 806.80664, 6097.022, 562949.8},
+	{13034.317, 576.38794, 5887.4653, 9.152217e+06},
+	{72592.055, 192.85887, 7053.974, 3.2552242e+06},
+	{7980.0254, 775.6092, 6153.073, 1.8377482e+06},
+	{67158.305, 519.2048, 7240.724, 7.677128e+06},
+	{14714.76, 774.0281, 2787.2583, 6.521207e+06},
+	{30007.992, 129.46321, 2254.1287, 8.817043e+06},
+	{98003.09, 799.70764, 7011.944, 6.6559495e+06},
+	{53205.414, 101.729576, 5943.4014, 9.245575e+06},
+	{55019.78, 249.83057, 4406.715, 5.9498265e+06},
+	{14402.309, 814.4037, 2774.1448, 7.383493e+06},
+	{90412, 217.74104, 4391.3564, 8.2788405e+06},
+	{17706.506, 993.472, 8792.604, 3.6466035e+06},
+	{75157.46, 771.854, 7727.1685, 5.7463275e+06},
+	{54000.504, 263.64462, 5216.52, 1.1226512e+06},
+	{3398.349, 262.72684, 618.96326, 8.3052125e+06},
+	{68263.99, 624.1233, 9773.207, 4.862565e+06},
+	{82386.57, 911.94434, 9398.322, 9.416965e+06},
+	{19808.027, 898.7235, 5855.366, 3.075682e+06},
+	{45627.17, 300.43686, 3530.452, 6.105594e+06},
+	{83804.695, 677.1259, 9775.662, 9.236892e+06},
+	{1704.8159, 356.51465, 3584.7756, 5.708543e+06},
+	{42156.902, 827.7901, 7624.483, 3.2327595e+06},
+	{7538.0024, 195.64453, 4478.2188, 9.703464e+06},
+	{99090.39, 298.2049, 6659.773, 7.474538e+06},
+	{93957.94, 48.771538, 8790.015, 8.073677e+06},
+	{11639.255, 843.36816, 9648.09, 398464.88},
+	{98010.67, 362.1608, 874.306, 1.2264942e+06},
+	{47280.008, 371.013, 1914.2998, 2.2607075e+06},
+	{73935.07, 102.83253, 2128.0833, 3.1774232e+06},
+	{42726.01, 778.22156, 306.73383, 6.8533845e+06},
+	{4926.2065, 146.107, 8691.705, 5.89865e+06},
+	{10195.3955, 839.3285, 8582.537, 1.8876476e+06},
+	{75240.97, 51.505215, 1958.3539, 3.658434e+06},
+	{9813.877, 954.3508, 964.9793, 9.071031e+06},
+	{92380.93, 334.61685, 7471.406, 5.403982e+06},
+	{69302.5, 56.45072, 5995.6606, 9.117226e+06},
+	{56844.34, 419.6072, 5240.5005, 5.553071e+06},
+	{69795.695, 108.28231, 5717.6265, 6.217831e+06},
+	{5575.939, 732.6755, 9561.766, 7.9150555e+06},
+	{43793.66, 329.0364, 1487.1401, 4.1250685e+06},
+	{67691.336, 79.81742, 8590.1455, 8.397339e+06},
+	{37531.89, 795.9387, 9397.232, 4.0851052e+06},
+	{66932.375, 768.22156, 7848.4746, 7.5348735e+06},
+	{1575.7598, 746.7159, 4733.264, 8.443375e+06},
+	{51277.055, 318.83124, 4792.98, 669909.06},
+	{71445.086, 593.20276, 478.9511, 5.921303e+06},
+	{54962.31, 522.1445, 4792.9336, 4.9910485e+06},
+	{86162.78, 211.38507, 449.5954, 8.222931e+06},
+	{72954.31, 917.30255, 6493.5957, 5.5165585e+06},
+	{65874.84, 542.62665, 1621.0364, 5.578426e+06},
+	{75048.48, 283.73413, 9998.738, 708784.7},
+	{47393.58, 432.36957, 5347.408, 3.4709795e+06},
+	{54096.895, 792.95953, 2546.2156, 3.5895852e+06},
+	{97959.31, 972.7188, 1795.5898, 9.458518e+06},
+	{60004.516, 270.3009, 2850.9531, 7.642304e+06},
+	{1001.65015, 3378.3738, 345.45325, 9137.829},
+	{5901.3115, 8783.216, 3024.8772, 6417.8867},
+	{7463.7627, 2570.637, 6042.648, 5433.7026},
+	{2953.6123, 8632.922, 4706.4946, 687.8472},
+	{5969.6704, 5851.1187, 7944.772, 7285.872},
+	{2402.5273, 1841.6315, 7486.464, 5295.8325},
+	{1599.2759, 4977.542, 2841.8167, 6258.8286},
+	{5210.387, 3181.9329, 6039.743, 4859.622},
+	{7946.718, 4284.717, 5970.539, 8887.3955},
+	{3089.6584, 5530.022, 3368.8845, 3795.236},
+	{7544.573, 2119.337, 7726.855, 2304.0562},
+	{383.29843, 8746.049, 7132.353, 5672.6455},
+	{1371.3213, 8255.618, 9595.8, 254.00815},
+	{549.5977, 7849.1104, 4727.602, 635.4823},
+	{9017.181, 1405.7092, 7940.0737, 5538.108},
+	{2468.2478, 7344.954, 6319.7695, 1709.0211},
+	{5692.0034, 9720.78, 8985.934, 9701.619},
+	{4780.85, 8773.858, 5323.7837, 5355.7646},
+	{6619.668, 6349.565, 959.53925, 5497.6143},
+	{4521.868, 2926.5261, 9179.251, 1324.9008},
+	{2686.6558, 6234.7427, 3387.781, 2600.7883},
+	{7733.5576, 5381.754, 1421.0717, 6200.1924},
+	{6226.96, 1791.2524, 5956.859, 6437.2764},
+	{6863.3604, 8525.839, 4441.6304, 3677.0247},
+	{5551.2017, 4224.867, 3669.2053, 1466.8442},
+	{9515.66, 6879.639, 3381.3914, 1134.163},
+	{9637.267, 4796.756, 2370.54, 6168.5923},
+	{280.59283, 5203.401, 9945.541, 4200.4985},
+	{6141.5894, 1346.8928, 8303.18, 9114.849},
+	{8997.427, 8037.465, 718.6207, 2394.2393},
+	{2047.8087, 9059.888, 1529.5875, 3285.5852},
+	{2676.3281, 6453.962, 4980.7334, 6203.7344},
+	{6739.924, 9649.372, 6534.7417, 6001.6724},
+	{5514.98, 4985.5537, 364.9301, 4694.6797},
+	{4769.4624, 3817.7559, 5169.0312, 7192.937},
+	{387.3003, 5857.0767, 7100.83, 1082.2786},
+	{6758.62, 7486.211, 9572.126, 4734.468},
+	{6542.6094, 3016.7085, 7505.253, 4236.4453},
+	{6986.488, 4275.38, 4463.345, 1105.3109},
+	{8364.335, 3660.0156, 3253.2605, 9609.03},
+	{5858.6724, 3720.2156, 2794.4844, 4787.8013},
+	{234.14413, 9360.537, 3725.888, 6746.106},
+	{3627.253, 609.1529, 8954.138, 2208.2212},
+	{25.670109, 9253.301, 7081.541, 9051.462},
+	{9539.61, 5658.6787, 9252.064, 8392.223},
+	{906.78015, 5446.4893, 523.65454, 5375.057},
+	{5578.2163, 3860.0598, 7519.4976, 2188.4353},
+	{5703.6772, 8670.265, 1388.3889, 7918.3765},
+	{872.8903, 6145.964, 5557.301, 5667.2197},
+	{7672.7974, 6056.57, 4475.3154, 7344.1577},
+	{9629.998, 1699.8613, 5639.9517, 4079.9368},
+	{9388.339, 7891.2207, 1175.3583, 8771.229},
+	{634.5711, 2097.5806, 1841.5112, 5300.809},
+	{5109.3257, 4765.0415, 2835.0503, 4794.174},
+	{5451.558, 8135.7515, 8610.387, 6796.464},
+	{2312.1082, 3312.6904, 1355.4756, 1303.0709},
+	{5237.5156, 9721.458, 4583.658, 1564.4149},
+	{8563.944, 4868.0356, 6987.3203, 8394.415},
+	{2226.527, 7001.2153, 9146.612, 450.84048},
+	{7472.2485, 8707.0625, 9272.404, 6817.267},
+	{2484.9382, 9532.264, 833.8329, 3363.7092},
+	{6078.445, 9756.558, 6346.594, 8433.826},
+	{9218.009, 9844.744, 7611.607, 2170.4155},
+	{4322.5977, 1971.6309, 4898.3687, 4939.629},
+	{3798.8086, 2244.0703, 4346.784, 4086.5232},
+	{4341.7153, 5402.475, 1582.1329, 8609.576},
+	{1419.1323, 4098.1772, 5885.3286, 2953.89},
+	{2075.854, 1173.8746, 6448.507, 6063.952},
+	{6818.518, 4288.342, 6943.083, 9118.141},
+	{2040.1709, 5761.473, 3037.7246, 6134.3726},
+	{5950.2803, 5699.7544, 2670.2688, 7725.1},
+	{3474.3232, 1974.7632, 6323.8296, 29.96819},
+	{1899.0956, 3663.1477, 152.11688, 5382.392},
+	{8872.895, 2795.0469, 9237.855, 7882.5},
+	{8774.982, 4978.1934, 5366.34, 2538.8196},
+	{2952.9185, 2218.1975, 6434.122, 2939.5615},
+	{6858.7173, 2463.9827, 6262.148, 9045.75},
+	{7258.107, 4527.743, 1503.7274, 5857.6343},
+	{5239.2373, 1644.0878, 1052.4252, 5916.118},
+	{5485.5234, 4908.625, 9888.588, 1492.3992},
+	{2575.423, 3813.1602, 4754.886, 7023.612},
+	{5876.0317, 9633.057, 4727.754, 3552.5396},
+	{219.51384, 4905.3843, 7549.6187, 611.1449},
+	{2017.9944, 2781.8726, 1224.2246, 6583.9316},
+	{349.23615, 302.9414, 5197.294, 2287.411},
+	{3895.3936, 646.7463, 2125.8035, 9733.558},
+	{5328.055, 247.23386, 8012.884, 9246.561},
+	{1596.8145, 8725.209, 5615.258, 7442.2095},
+	{3382.9797, 5210.2354, 5583.7686, 7384.207},
+	{8511.169, 1786.1818, 1415.4236, 1484.8148},
+	{2693.9258, 4752.335, 2538.717, 3584.862},
+	{4538.5283, 7465.885, 3550.205, 1307.4364},
+	{4825.067, 6938.7, 493.5136, 2079.203},
+	{902.4213, 4032.7961, 4606.97, 5018.3384},
+	{6062.7383, 9605.896, 845.867, 2817.294},
+	{6527.7153, 3542.0312, 6188.27, 9280.944},
+	{423.12613, 4061.0337, 2442.3386, 2200.7554},
+	{6322.7573, 6394.052, 333.21857, 8647.607},
+	{5551.683, 4422.072, 741.0763, 1595.219},
+	{2943.6995, 1069.5623, 9284.187, 6097.383},
+	{-2.9857638e+09, 1.1177513e+09, -8.880644e+08, 1.546103e+09},
+	{1.8957267e+09, 2.1316685e+09, 7.0930675e+08, 8.401799e+08},
+	{3.1121485e+09, -1.5901062e+09, 3.9302702e+09, -4.0081142e+09},
+	{1.5265706e+09, 6.876427e+08, -2.6290465e+09, 1.6262232e+08},
+	{-2.3960054e+08, 3.998721e+09, -3.0075213e+08, -1.614338e+09},
+	{-2.2414075e+09, -3.3123512e+09, -2.5029074e+09, -9.259445e+08},
+	{-2.1950405e+09, 8.2171283e+08, 3.3599355e+09, -2.0238764e+09},
+	{2.6624778e+09, -2.5719974e+09, 3.056109e+09, 2.5735442e+09},
+	{-5.284126e+07, 2.2959793e+09, -2.3718835e+09, 3.545604e+09},
+	{-8.21375e+08, -4.0770816e+09, -3.4653494e+09, 2.3437834e+09},
+	{-5.1834797e+08, -2.4771392e+09, -8.368525e+08, -2.1044177e+09},
+	{-1.4341016e+09, 2.2475154e+09, 7.580556e+08, 1.812701e+09},
+	{2.2301553e+09, 2.730064e+09, 2.3868834e+08, 8.81889e+08},
+	{-9.6934106e+08, -3.3318272e+09, 1.6960381e+09, -1.5646209e+09},
+	{-7.653052e+08, 3.8742828e+09, -4.119193e+09, 4.231814e+08},
+	{-3.8489592e+09, -3.3391928e+09, -2.809342e+08, -4.1194176e+07},
+	{1.6483044e+09, 4.2280067e+09, -3.3045722e+09, 1.8722921e+09},
+	{3.5532503e+09, 2.2131082e+09, -1.2884865e+09, -1.9321532e+09},
+	{-1.2891533e+09, 1.9142957e+09, -3.5810028e+09, 7.296511e+07},
+	{-1.3285489e+09, -4.3596477e+08, -3.0393106e+09, -3.2662482e+09},
+	{3.7331812e+09, -3.8422623e+09, 1.4724689e+09, -1.794892e+09},
+	{3.9754593e+09, 3.9300252e+09, 4.0971658e+09, 1.9525165e+09},
+	{-1.02418886e+09, 8.8949875e+08, 2.3399936e+09, -2.8399892e+09},
+	{3.8536538e+09, 5.6449517e+08, -1.6205478e+09, -3.3609605e+09},
+	{7.53531e+08, -2.9579926e+08, -3.5231562e+09, -3.7007268e+09},
+	{-3.3465057e+09, -2.8542758e+09, -2.6490857e+09, -4.097638e+09},
+	{4.2279018e+08, 2.9782912e+09, -2.5278486e+08, -1.2349298e+09},
+	{-1.459233e+09, 1.0846003e+09, -2.6242616e+09, 1.1225078e+09},
+	{-3.7184125e+09, -3.319557e+09, -1.3583558e+09, 1.0242729e+09},
+	{-1.1959354e+09, -3.1737167e+09, -1.9475578e+09, 1.0306922e+09},
+	{-4.0298583e+09, -1.9267505e+09, 2.2486845e+08, -3.7207311e+09},
+	{-5.745321e+08, 9.662486e+08, -7.153459e+07, 3.3169464e+09},
+	{3.3546926e+09, -9.223975e+08, 2.9642084e+09, -2.3978835e+08},
+	{-3.8489697e+09, -5.9511744e+08, 4.1171566e+09, -3.159384e+09},
+	{3.9569943e+09, 4.8704256e+08, 2.6907574e+09, -7.004782e+08},
+	{1.5780154e+09, 2.8017242e+09, -9.932738e+08, -3.1810803e+09},
+	{-6.2693732e+07, -4.7335264e+08, 4.0610555e+09, -2.4146373e+09},
+	{4.944864e+08, -1.7670525e+09, 3.4748946e+09, 2.4885312e+09},
+	{3.0419028e+09, -1.3853507e+09, 1.911151e+09, -1.7975685e+09},
+	{4.6135683e+08, -7.961443e+08, 3.9324168e+09, -1.4377341e+09},
+	{-419.1237, 282.04123, 390.0495, -195.17923},
+	{165.59187, -590.0442, -108.26868, 961.08704},
+	{-455.60272, 563.2989, -548.7817, 362.7937},
+	{560.8847, 448.6085, -202.77261, -645.21216},
+	{500.36063, -387.98907, 167.8173, -822.28687},
+	{-64.27266, -582.1731, 539.70447, -129.6181},
+	{-581.1594, -499.09186, -446.58017, 101.92385},
+	{-78.35111, -890.4013, -439.79688, 308.84296},
+	{-660.3761, 630.8649, 338.66705, -497.33167},
+	{-225.16052, 869.79486, 659.1011, 96.358925},
+	{-120.72107, 977.45996, 196.24127, -138.00719},
+	{514.469, 613.25464, -323.70294, -387.67343},
+	{-251.20851, 387.9137, 934.48303, 89.441925},
+	{556.44385, 183.06195, 481.13925, 861.75146},
+	{245.8725, -90.08242, -307.08835, -834.46893},
+	{-916.19727, -19.32403, -628.2872, 626.4167},
+	{13.578591, -589.51434, 185.54753, 516.25305},
+	{-6.1440654, -352.32977, -635.4293, 119.14394},
+	{359.05463, -86.07525, -331.4841, -551.46136},
+	{599.0194, 718.21814, 734.9689, -121.392296},
+	{-368.07898, -83.48909, -118.80064, 781.7256},
+	{-60.054985, -353.55762, 552.30664, 593.74005},
+	{368.028, 432.2911, -853.5583, 157.83284},
+	{-515.3094, 325.73993, 49.090473, -152.6845},
+	{-73.61784, 814.5186, -429.31137, -370.9156},
+	{-50.59241, -562.2025, -123.10539, -626.9487},
+	{-19.998915, -944.77075, -513.3456, 247.23924},
+	{-301.06403, -859.68646, 690.72174, -456.46478},
+	{583.3388, 216.73181, -660.81055, -257.1202},
+	{230.61417, -25.081936, 683.7717, -377.50473},
+	{-623.6776, -543.7049, -881.33136, -129.60463},
+	{152.83533, -333.28113, -674.4489, -682.12665},
+	{-677.20135, 3.1563666, -761.0525, 95.68443},
+	{518.43805, 388.06573, 248.348, -239.93828},
+	{409.10522, -278.36908, -827.969, 456.02914},
+	{924.1206, 196.92957, -650.1025, 718.1801},
+	{-967.43494, 45.98091, 922.8206, -253.1361},
+	{790.7817, -229.3172, -606.0432, 616.23193},
+	{-633.7627, -483.4468, 435.971, -88.47904},
+	{-6.5593204, 834.835, -182.52513, 212.12988},
+	{301.65576, 912.24927, -818.94, 557.9946},
+	{244.24052, 361.77585, 768.4209, 43.702187},
+	{290.2518, -316.14844, 334.95276, 874.7243},
+	{1.8646634, 935.0671, 654.07983, 638.8473},
+	{-534.76843, 477.2171, -521.8452, -801.0896},
+	{395.7618, 166.80943, 465.70193, -44.71647},
+	{675.1641, -455.66855, -615.18134, 110.11764},
+	{573.8037, 268.3788, -79.30173, 905.1928},
+	{-249.4297, 964.27527, 366.80502, -246.11226},
+	{-614.3, 763.1659, -514.7852, -352.00403},
+	{8.233851e-14, -7.557582e-14, 2.4434439e-17, 2.785105e-17},
+	{7.498523e-27, -4.0005683e-27, -0.011822909, -0.005731457},
+	{-6.966585e+09, -1.4804202e+09, -1.606746e+20, 6.826602e+19},
+	{0.014480032, -0.03420459, 3.6550853e+18, -7.796812e+18},
+	{3.103424e+18, 8.553793e+17, 2.0635138e-09, 2.2025026e-09},
+	{-2.9897404e-10, 2.0334914e-10, -6.765703e-15, 2.8057435e-15},
+	{4.989949e-08, 1.7631179e-09, 1.7585088e+20, 1.1579642e+22},
+	{-1.5361461e-18, 2.326904e-19, -1.9745333e-15, -8.360381e-16},
+	{667171.1, -4.402715e+06, -1.080466e+13, -4.2616375e+12},
+	{-0.009953295, -0.029051539, 0.0015390613, 0.0005615688},
+	{-8.041468e-08, -5.2190103e-08, -5.112803e-23, -1.477565e-23},
+	{2.188253e+07, 1.8973955e+08, -3.9971464e-18, 4.278594e-18},
+	{4.765687e-13, 2.4556798e-12, -4.4747795e+06, 7.752058e+06},
+	{3.307036e+24, 1.9068193e+24, 907.1405, -1895.5942},
+	{-8.335471e-12, 7.9785814e-13, -72.619125, -336.89172},
+	{-9.635399e+24, 8.141788e+22, 2.8584595e-31, -1.0283014e-30},
+	{-79.97085, -439.91226, -8.007935e-09, 5.924564e-09},
+	{4.2081747e+24, 4.8167486e+23, -7.2308827e+18, 6.8251123e+18},
+	{3.9114443e+12, -2.9843178e+12, -1.6407855e+09, -1.145377e+11},
+	{-5.092899e+20, -1.3040382e+21, 1.8804864e+22, 1.3623239e+22},
+	{-1.9987658, 16.90562, 1.639546e-13, -7.9555225e-14},
+	{4.750735e-13, -2.6027645e-13, 2.6953094e+07, -1.1180457e+07},
+	{-1.4891884e-24, -7.085429e-25, -5.2573262e+17, 1.8233885e+17},
+	{2.7425814e-14, 6.922192e-14, 0.00017560323, 0.007143452},
+	{-6.1678014e+10, 1.3592498e+11, 1.5848131e+24, 3.128532e+25},
+	{-1263.3583, -4590.7905, -0.00015937914, -6.7667934e-05},
+	{2.1786611e+11, 1.2539136e+11, 4.1193365e+17, -5.868472e+17},
+	{-0.00015846342, -0.00016470619, 1.7179654e+07, 1.2199871e+07},
+	{32066.154, -57600.676, 1.2832515e+28, 5.973106e+27},
+	{1.06979136e-16, -2.6801727e-16, 2.2912497e-26, 6.5835835e-27},
+	{1.510429e-28, -6.776841e-29, 4.6977896e+11, -2.3558013e+13},
+	{-8.2370563e+15, -2.3259352e+16, 3.8775288e+17, -1.1330972e+17},
+	{3.5965184e-17, 2.2536612e-17, 1.3323764e-10, -3.1111513e-11},
+	{5.719047e+08, -1.2814158e+09, 78015.76, 251519.78},
+	{5.768434e-23, 5.2228576e-21, 6.3468227e+25, 2.2746348e+26},
+	{3.7147996e-23, -5.8486e-22, 5.4949025e-26, -3.7481728e-26},
+	{-9.066794e-05, 2.9146233e-05, 2.1974742e-24, -4.787924e-24},
+	{1.3120503e+09, 4.907424e+09, 8.162332e+16, -2.118147e+18},
+	{2.6030088e+11, 1.5778868e+11, -5.6265118e-30, 6.28557e-30},
+	{-5.800044e-15, -4.940153e-15, -1.3811242e-28, -1.7962678e-28},
+	{-1.8164088e+17, -1.9087324e+17, 1217.756, -508.851},
+	{77753.27, 37907.277, 679.0317, 549.0883},
+	{-2.8264817e-09, 9.497744e-09, 1.1587631e+25, 5.921514e+24},
+	{-2.8632279e-08, -1.3607693e-07, -1.8868335e+14, -2.6544582e+13},
+	{0.0072947405, -0.00675143, 1.0279186e+09, -7.538425e+08},
+	{1.4341565e+28, 5.391743e+27, 1.04817674e-26, 4.3690187e-27},
+	{-0.00023459495, 0.001172156, 1.3489086e-22, 1.3942416e-22},
+	{-0.00028141082, -0.0002451618, -5.2077643e-22, -6.030541e-23},
+	{-1.4318845e+18, 3.048412e+19, 7.1776644e+24, -2.3411048e+24},
+	{-5.1657632e-23, -1.85681e-23, -1.1654039e-15, 2.7585386e-16},
+	{-0.00093690166, 2.1919622e+38, 1.484069e+33, 1.341779e+22},
+	{-1.4335094e+07, 3.2256564e-25, -7.396816e-32, -5.6472793e+13},
+	{-1.1515016e+10, 1.5462678e-11, -8.2104384e-26, 109.124306},
+	{-5.3807134e-33, -2.9733372e-36, -1.5573718e+14, 1.7029119e+28},
+	{0.00047882283, 9.428341e+33, -1.3366129e+08, 1.6528942e+06},
+	{8.57106e-16, 1.779815e+09, 2.0445675e+08, -9.351503e-33},
+	{-1.7465576e+13, -4.3134324e-16, 6.2605103e-18, -1.5365908e-18},
+	{3.0704123e-30, -6.6603157e+28, 2.57028e-37, 2.063051e-19},
+	{-1.9096483e+25, 8.017967e-11, -4.7491825e-19, 7.007938e-33},
+	{432398.78, 1.2458899e+35, 1.982543e-31, 4.9215853e+12},
+	{-1.0141866e-19, -385129.06, 2.0556241e-12, -1.2398231e+06},
+	{-1.2687478e-26, 1.531586e-16, 8.063685e-11, -1.8483066e-05},
+	{-6.186402e-06, 3.6005917e-16, -3073.9326, -2.699241e-19},
+	{-9498.313, -47.38269, -6.0043535e-16, 1.2084227e-18},
+	{-4.7280183e+13, -2.7368e+36, -6.321174e+26, 2.4269684e-14},
+	{-5.205641e-34, 1.4244787e-11, -2.3014307e+11, 1.0838556e-08},
+	{-5.6155204e-21, -1.4056435e+37, -15693.686, 1.1527894e+07},
+	{-3.0084232e-21, 1.9756868e-07, 1.23412616e+20, 1.2724232e-37},
+	{1.9510017e+33, 8.575695e-12, -1.0662633e-35, -2.0760494e+17},
+	{2.5815836e-05, -1.1277409e+16, -2.7313889e+23, 1.5716022e+06},
+	{3.4837842e-06, -14.385751, -8.087873e+06, 3.0422035e-21},
+	{-3.37012e+06, 3.7868022e-35, 1.2054126e+32, -7.707565e+07},
+	{4.2198048e-10, 6.2418823e+13, 6.668183e-09, 2.3478769e+26},
+	{-3.9572675e-25, 1.180053e+34, 1.1418448e+22, -1.5239182e+21},
+	{1.9340674, -8.995295e+23, 4.882777e+18, 5.12616e-18},
+	{-6.29299e+23, 4.3002752e+08, -6.5284134e-22, 1104.7008},
+	{9.153546e-32, 7.3178205e+36, 1.2880171e+12, 1.1634821},
+	{2.9379588e-23, 1.296356e+30, -2.3068209e-14, 2.163841e-26},
+	{-4.7231482e+33, -5.0103767e+30, 3829.643, 9.962424e+12},
+	{-1.3843476e+28, -2.5940636e+13, 1.0966015e-12, -5.766415e-34},
+	{-2.0275986e+28, 1.6855432e+22, 0.0008319225, 3.6169976e+07},
+	{1.4300283e-28, 8.26749e+30, -9.318306, -8.5671905e+09},
+	{215873.4, 2.7664665e-20, -0.00018866462, -4.292359e-14},
+	{8.2652485e-05, 3.3984566e-21, 2.7946156e+27, 0.005088437},
+	{-3.3171564e-37, -1.256851e+25, -90077.06, -3.8204467e-23},
+	{3.282327e-06, 2.3802163e+28, -46373.156, -7.654125e-11},
+	{-348.33392, 0.000117014606, -8.378555e-05, -3.3066347e+18},
+	{1.9521111e-09, -1.3371715e-18, -7.7989487e+27, -0.054586764},
+	{-42.26024, 369.31857, -6.179766e+18, 1.6461134e+34},
+	{-8.5720455e+27, -1.4117937e+24, -1.1815341e-09, -754449.3},
+	{2.2276795e-14, 5.5457443e-37, -2.7385524e-11, 7.482849e+30},
+	{2.1831264e+13, -5.560686e-34, -1.3074703e+11, -1.9927799e-12},
+	{-3.772911e-15, -2.5355187e+29, -5.082816e+18, -7.6447686e-05},
+	{-5.360685e+24, 1.4149658e+26, -0.0073770555, -5.3545816e+25},
+	{-1.0100189e+24, -0.21798845, -1.1599009e+10, -3.2767835e-35},
+	{-1.1379105e+17, 3.8251235e+25, 3.9042443e-35, -2.396344e-29},
+	{-6.2863977e-15, 1.2191068e+25, -4.7048764e+07, -2.5486492e+35},
+	{-2.7676346e+10, 5.87219e-22, 6.022539e+31, 1.0438267e-07},
+	{-0.00013794965, 4.4657958e-07, -4.105303e-16, 1.350028e-05},
+	{-3.201865e+16, 1.9168432e+22, -2.9585604e+18, -4.2261255e+18},
+	{1, float32(math.Inf(-1)), 1e-45, -1},
+	{math.Float32frombits(0x7fc00000), 1e-45, -1e-45, float32(math.Copysign(0, -1))},
+	{float32(math.Copysign(0, -1)), -3.4028235e+38, float32(math.Inf(-1)), float32(math.Inf(+1))},
+	{-1, float32(math.Inf(-1)), float32(math.Inf(+1)), -3.4028235e+38},
+	{float32(math.Inf(+1)), float32(math.Inf(-1)), float32(math.Inf(+1)), 1e-45},
+	{-1, -3.4028235e+38, float32(math.Copysign(0, -1)), -1e-45},
+	{0, 1, float32(math.Inf(-1)), 3.4028235e+38},
+	{math.Float32frombits(0x7fc00000), 1e-45, 1e-45, 3.4028235e+38},
+	{1.1754944e-38, -1e-45, math.Float32frombits(0x7fc00000), float32(math.Copysign(0, -1))},
+	{3.4028235e+38, 1, -1, float32(math.Inf(+1))},
+	{float32(math.Inf(-1)), -3.4028235e+38, 1e-45, -1},
+	{-1, -1e-45, float32(math.Copysign(0, -1)), 1},
+	{1e-45, float32(math.Inf(+1)), 3.4028235e+38, float32(math.Inf(+1))},
+	{float32(math.Inf(-1)), -1, math.Float32frombits(0x7fc00000), float32(math.Copysign(0, -1))},
+	{1e-45, float32(math.Inf(-1)), 3.4028235e+38, 1},
+	{float32(math.Inf(-1)), float32(math.Copysign(0, -1)), -1, -1},
+	{-3.4028235e+38, 1e-45, float32(math.Inf(+1)), 1e-45},
+	{1.1754944e-38, float32(math.Inf(+1)), -1e-45, 1.1754944e-38},
+	{math.Float32frombits(0x7fc00000), -1, float32(math.Inf(+1)), -1e-45},
+	{1e-45, float32(math.Inf(-1)), math.Float32frombits(0x7fc00000), math.Float32frombits(0x7fc00000)},
+	{3.4028235e+38, math.Float32frombits(0x7fc00000), float32(math.Copysign(0, -1)), -1e-45},
+	{0, 0, 0, float32(math.Inf(+1))},
+	{3.4028235e+38, 3.4028235e+38, 1, math.Float32frombits(0x7fc00000)},
+	{0, -1, -3.4028235e+38, 1e-45},
+	{-3.4028235e+38, 1, float32(math.Inf(+1)), math.Float32frombits(0x7fc00000)},
+	{-3.4028235e+38, -1, -1, float32(math.Copysign(0, -1))},
+	{3.4028235e+38, 1e-45, -3.4028235e+38, 0},
+	{math.Float32frombits(0x7fc00000), 1e-45, -1, -1},
+	{1, math.Float32frombits(0x7fc00000), 1e-45, float32(math.Inf(-1))},
+	{1e-45, 1.1754944e-38, float32(math.Copysign(0, -1)), -1e-45},
+	{float32(math.Inf(+1)), 1.1754944e-38, 1, math.Float32frombits(0x7fc00000)},
+	{float32(math.Inf(+1)), float32(math.Inf(-1)), 0, 1e-45},
+	{1.1754944e-38, 0, 1, 0},
+	{float32(math.Copysign(0, -1)), 3.4028235e+38, float32(math.Copysign(0, -1)), 0},
+	{1e-45, float32(math.Inf(-1)), -3.4028235e+38, 1.1754944e-38},
+	{-1e-45, 3.4028235e+38, float32(math.Inf(-1)), -3.4028235e+38},
+	{float32(math.Inf(-1)), 1e-45, 1e-45, math.Float32frombits(0x7fc00000)},
+	{-1, 1.1754944e-38, float32(math.Inf(-1)), 1},
+	{float32(math.Inf(-1)), -1e-45, math.Float32frombits(0x7fc00000), 1e-45},
+	{math.Float32frombits(0x7fc00000), -1, math.Float32frombits(0x7fc00000), -3.4028235e+38},
+	{1e-45, float32(math.Copysign(0, -1)), 0, math.Float32frombits(0x7fc00000)},
+	{float32(math.Inf(+1)), -1e-45, float32(math.Inf(-1)), -1},
+	{math.Float32frombits(0x7fc00000), 1e-45, float32(math.Inf(-1)), -1e-45},
+	{3.4028235e+38, float32(math.Inf(-1)), -3.4028235e+38, -1},
+	{float32(math.Inf(+1)), 1.1754944e-38, -1e-45, float32(math.Inf(+1))},
+	{-1, 1, 3.4028235e+38, float32(math.Copysign(0, -1))},
+	{float32(math.Inf(-1)), -1e-45, -3.4028235e+38, float32(math.Inf(+1))},
+	{-3.4028235e+38, float32(math.Inf(+1)), float32(math.Inf(+1)), float32(math.Inf(-1))},
+	{1, float32(math.Copysign(0, -1)), 1.1754944e-38, -1},
+	{math.Float32frombits(0x7fc00000), 0, 1, math.Float32frombits(0x7fc00000)},
+	{math.Float32frombits(0x7fc00000), 1e-45, -2.5562418, 0.07453432},
+	{-3.4028235e+38, 3.4028235e+38, 23.339779, 37.27068},
+	{0, -3.4028235e+38, 0.23515108, -0.14460681},
+	{float32(math.Inf(-1)), float32(math.Inf(+1)), -40.08057, 7.4387164},
+	{math.Float32frombits(0x7fc00000), 1e-45, 2.8707106, 0.6408531},
+	{-1, math.Float32frombits(0x7fc00000), 0.79080766, 0.95216763},
+	{3.4028235e+38, 1.1754944e-38, -2.0366895, -1.3338014},
+	{-1, -3.4028235e+38, 0.004058043, 0.0034369978},
+	{1e-45, 0, -0.48081344, 0.08909355},
+	{float32(math.Copysign(0, -1)), 1.1754944e-38, 0.012323231, -0.014909462},
+	{math.Float32frombits(0x7fc00000), 1e-45, 0.0025223445, 0.0010307771},
+	{-1e-45, 1e-45, -0.004852809, 0.021062803},
+	{-1, 1, -14.167614, 1.2368662},
+	{math.Float32frombits(0x7fc00000), 1e-45, 0.0047137095, 0.031757552},
+	{float32(math.Copysign(0, -1)), float32(math.Inf(-1)), -0.079514615, -0.15329967},
+	{float32(math.Inf(+1)), 1.1754944e-38, 4.624079, 9.269407},
+	{1.1754944e-38, float32(math.Inf(-1)), -37.884346, -159.18013},
+	{-1e-45, 3.4028235e+38, -0.049617577, -0.019345941},
+	{-1, float32(math.Inf(+1)), -0.0005951381, 0.0009797331},
+	{float32(math.Copysign(0, -1)), -3.4028235e+38, 0.1288042, 0.016566714},
+	{0, 3.4028235e+38, 60.48718, -124.61769},
+	{1, float32(math.Inf(-1)), 0.5627906, 0.22399242},
+	{1.1754944e-38, 0, -15.95655, -238.18327},
+	{1e-45, -3.4028235e+38, 9.679892, 22.573948},
+	{float32(math.Copysign(0, -1)), 1.1754944e-38, -0.0695986, -0.008519733},
+	{float32(math.Inf(+1)), 1.1754944e-38, 0.09760637, 0.023267025},
+	{3.4028235e+38, -3.4028235e+38, -0.06257333, 0.0532487},
+	{float32(math.Copysign(0, -1)), float32(math.Inf(-1)), 0.057391584, -0.009228378},
+	{1e-45, 1e-45, -9.372443, 9.657823},
+	{-3.4028235e+38, 3.4028235e+38, 16.303171, -68.63677},
+	{-1, float32(math.Inf(+1)), -0.3208325, -0.08806139},
+	{-1, -3.4028235e+38, 10.492699, -13.313899},
+	{-1e-45, 1, 0.008064229, -0.4067681},
+	{0, 0, 17.55155, -7.6435947},
+	{float32(math.Inf(-1)), -1, -110.13721, -26.013361},
+	{-1e-45, 1.1754944e-38, 121.10146, 539.93787},
+	{1.1754944e-38, 0, -2.9661844, 0.5512128},
+	{math.Float32frombits(0x7fc00000), math.Float32frombits(0x7fc00000), -1.4131216, 1.0921595},
+	{math.Float32frombits(0x7fc00000), -1, -7.142785, -2.9295027},
+	{1.1754944e-38, 0, 0.10962091, -0.26917166},
+	{0, math.Float32frombits(0x7fc00000), 1.3317708, -5.9119596},
+	{-1e-45, float32(math.Copysign(0, -1)), -12.054575, -4.803632},
+	{float32(math.Inf(-1)), float32(math.Copysign(0, -1)), 0.043642696, 0.013769553},
+	{-1, 0, -83.181305, 72.54717},
+	{float32(math.Inf(+1)), 1.1754944e-38, 0.07495563, 0.008238899},
+	{1e-45, -1e-45, 0.026804524, 0.013136788},
+	{-1, 0, -0.00065079477, 0.0011142417},
+	{-1e-45, -1, -0.0013964898, -0.01668298},
+	{1, math.Float32frombits(0x7fc00000), 3.8858943, -10.789795},
+	{-1, 1e-45, -2.599981, 1.3680795},
+}
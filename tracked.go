@@ -0,0 +1,94 @@
+package xmath
+
+import "math"
+
+// TrackedComplex64 is a Complex64 value with a running bound
+// of the accumulated rounding error: the distance between the value
+// and the result of the same computation done with exact arithmetic.
+//
+// The bound uses the standard floating-point error model,
+// where every rounding to float32 is fl(x) = x*(1+δ), |δ| <= 2^-24,
+// and error propagation rules of every operation.
+// The bound itself is computed with float64 precision.
+// Underflow to subnormal numbers is not taken into account.
+//
+// This type has value semantics, all operations return a
+// new instance of TrackedComplex64.
+type TrackedComplex64 struct {
+	v   Complex64
+	err float64
+}
+
+const (
+	// unitRoundoff32 is the float32 unit roundoff.
+	unitRoundoff32 = 0x1p-24
+	// roundoff32 is u/(1-u): a bound of the relative error
+	// of the rounded result with respect to the rounded value.
+	roundoff32 = unitRoundoff32 / (1 - unitRoundoff32)
+	// roundoff64 bounds float64 intermediate errors in Complex64
+	// Mul and Div, relative to the result magnitude.
+	roundoff64 = 0x1p-49
+)
+
+// NewTrackedComplex64 returns v with err initial error bound.
+// Exact inputs have zero err.
+func NewTrackedComplex64(v Complex64, err float64) TrackedComplex64 {
+	return TrackedComplex64{v: v, err: err}
+}
+
+// Value returns the computed value.
+func (c TrackedComplex64) Value() Complex64 { return c.v }
+
+// Err returns the bound of the absolute error of c.Value().
+func (c TrackedComplex64) Err() float64 { return c.err }
+
+// RelErr returns the bound of the relative error of c.Value().
+// For zero values it's +Inf, unless the error bound is also 0.
+func (c TrackedComplex64) RelErr() float64 {
+	if c.err == 0 {
+		return 0
+	}
+	return c.err / hypot64(c.v)
+}
+
+// Add is "+" operation.
+func (c TrackedComplex64) Add(x TrackedComplex64) TrackedComplex64 {
+	v := c.v.Add(x.v)
+	return TrackedComplex64{v: v, err: c.err + x.err + roundoff32*hypot64(v)}
+}
+
+// Sub is "-" operation.
+func (c TrackedComplex64) Sub(x TrackedComplex64) TrackedComplex64 {
+	v := c.v.Sub(x.v)
+	return TrackedComplex64{v: v, err: c.err + x.err + roundoff32*hypot64(v)}
+}
+
+// Mul is "*" operation.
+func (c TrackedComplex64) Mul(x TrackedComplex64) TrackedComplex64 {
+	v := c.v.Mul(x.v)
+	a, b := hypot64(c.v), hypot64(x.v)
+	// (c+e1)(x+e2) - cx = c*e2 + e1*x + e1*e2.
+	prop := a*x.err + c.err*b + c.err*x.err
+	return TrackedComplex64{v: v, err: prop + roundoff64*a*b + roundoff32*hypot64(v)}
+}
+
+// Div is "/" operation.
+//
+// If the error bound of x is not less than |x|, the divisor
+// may be zero and the error bound is +Inf.
+func (c TrackedComplex64) Div(x TrackedComplex64) TrackedComplex64 {
+	v := c.v.Div(x.v)
+	b := hypot64(x.v)
+	if x.err >= b {
+		return TrackedComplex64{v: v, err: math.Inf(1)}
+	}
+	q := hypot64(c.v) / b
+	// |(c+e1)/(x+e2) - c/x| = |e1 - q*e2| / |x+e2|.
+	prop := (c.err + q*x.err) / (b - x.err)
+	return TrackedComplex64{v: v, err: prop + roundoff64*q + roundoff32*hypot64(v)}
+}
+
+// hypot64 returns |c| computed with float64 precision.
+func hypot64(c Complex64) float64 {
+	return math.Hypot(float64(c.r), float64(c.i))
+}
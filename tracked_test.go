@@ -0,0 +1,109 @@
+package xmath
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+// Helper functions.
+
+// ttActualError returns |c.Value() - want|.
+func ttActualError(c TrackedComplex64, want *BigComplex) float64 {
+	diff := new(BigComplex).SetPrec(want.Prec()).SetComplex64(c.Value())
+	diff.Sub(diff, want)
+	f, _ := diff.Abs().Float64()
+	return f
+}
+
+// Unit tests.
+
+func TestTrackedComplex64Bound(t *testing.T) {
+	type op struct {
+		name    string
+		tracked func(x, y TrackedComplex64) TrackedComplex64
+		big     func(z, x, y *BigComplex) *BigComplex
+	}
+	ops := []op{
+		{"+", TrackedComplex64.Add, (*BigComplex).Add},
+		{"-", TrackedComplex64.Sub, (*BigComplex).Sub},
+		{"*", TrackedComplex64.Mul, (*BigComplex).Mul},
+		{"/", TrackedComplex64.Div, (*BigComplex).Quo},
+	}
+
+	rng := rand.New(rand.NewPCG(47, 0))
+	value := func() Complex64 {
+		part := func() float32 {
+			return float32(math.Copysign(math.Exp(rng.Float64()*6-3), rng.Float64()-0.5))
+		}
+		return NewComplex64(part(), part())
+	}
+
+	var maxTightness float64
+	for chain := 0; chain < 200; chain++ {
+		x := value()
+		acc := NewTrackedComplex64(x, 0)
+		want := new(BigComplex).SetPrec(500).SetComplex64(x)
+		for step := 0; step < 40; step++ {
+			op := ops[rng.IntN(len(ops))]
+			y := value()
+			acc = op.tracked(acc, NewTrackedComplex64(y, 0))
+			op.big(want, want, new(BigComplex).SetComplex64(y))
+
+			actual := ttActualError(acc, want)
+			if actual > acc.Err() {
+				t.Fatalf("chain %d, step %d (%s): error bound is too small;\nactual: %g\nbound:  %g",
+					chain, step, op.name, actual, acc.Err())
+			}
+			if step == 0 {
+				maxTightness = max(maxTightness, acc.RelErr())
+			}
+		}
+	}
+	// Single operation on exact inputs is rounded once or twice.
+	if maxTightness > 3*0x1p-24 {
+		t.Errorf("single operation relative bound is too loose: %g", maxTightness)
+	}
+}
+
+func TestTrackedComplex64(t *testing.T) {
+	one := NewTrackedComplex64(NewComplex64(1, 0), 0)
+	three := NewTrackedComplex64(NewComplex64(3, 0), 0)
+
+	// 1/3*3 is rounded to 1 exactly, but the error bound knows better.
+	x := one.Div(three).Mul(three)
+	if x.Value() != NewComplex64(1, 0) || x.Err() == 0 {
+		t.Fatalf("1/3*3: have %v ± %v", x.Value(), x.Err())
+	}
+	// Catastrophic cancellation leaves no correct bits.
+	d := x.Sub(one)
+	if !d.Value().IsZero() || !math.IsInf(d.RelErr(), 1) {
+		t.Errorf("1/3*3 - 1: have %v ± %v", d.Value(), d.Err())
+	}
+	exact := new(big.Float).SetPrec(100).SetFloat64(float64(float32(1.0 / 3)))
+	exact.Mul(exact, big.NewFloat(3))
+	exact.Sub(exact, big.NewFloat(1))
+	if diff, _ := exact.Float64(); diff > d.Err() {
+		t.Errorf("1/3*3 - 1: exact result %v is outside of ±%v", diff, d.Err())
+	}
+
+	if have := one.Div(NewTrackedComplex64(NewComplex64(0.5, 0), 0.5)); !math.IsInf(have.Err(), 1) {
+		t.Errorf("division by possible zero: have %v ± %v", have.Value(), have.Err())
+	}
+	// The model doesn't know that the sum is exact.
+	if have := one.Add(one); have.Err() > 2*0x1p-23 || have.RelErr() > 2*0x1p-24 {
+		t.Errorf("1+1: have %v ± %v", have.Value(), have.Err())
+	}
+}
+
+// Performance tests.
+
+var ttTracked TrackedComplex64
+
+func BenchmarkMulTracked(b *testing.B) {
+	bench(b.N, func(x, y Complex64) {
+		p, q := NewTrackedComplex64(x, 0), NewTrackedComplex64(y, 0)
+		ttTracked = p.Mul(q).Mul(p).Mul(q)
+	})
+}
@@ -0,0 +1,202 @@
+package vec
+
+import (
+	"math"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// This file implements BLAS level-1 routines.
+//
+// Functions with "Inc" suffix accept an explicit number of elements n
+// and strides for every slice argument; element k of x is x[k*incX].
+// Strides must be positive, n must not be negative.
+// Other functions operate on all slice elements with unit stride.
+
+const (
+	errInc   = "vec: non-positive increment"
+	errShort = "vec: slice is too short"
+	errCount = "vec: negative element count"
+)
+
+// checkInc panics if n is negative or x has less than n elements with incX stride.
+func checkInc(n int, x []xmath.Complex64, incX int) {
+	if n < 0 {
+		panic(errCount)
+	}
+	if incX <= 0 {
+		panic(errInc)
+	}
+	if n > 0 && (n-1)*incX >= len(x) {
+		panic(errShort)
+	}
+}
+
+// Dot returns the sum of a[i]*b[i] products.
+//
+// Products and partial sums are computed with float64 precision,
+// the result is rounded to Complex64 once.
+// Elements with even and odd indexes are summed separately
+// and then added together, so the result is reproducible
+// regardless of the used SIMD instruction set.
+func Dot(a, b []xmath.Complex64) xmath.Complex64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	return kernels.dot(a, b)
+}
+
+// DotInc is like Dot, but operates on strided slices.
+func DotInc(n int, x []xmath.Complex64, incX int, y []xmath.Complex64, incY int) xmath.Complex64 {
+	checkInc(n, x, incX)
+	checkInc(n, y, incY)
+	if incX == 1 && incY == 1 {
+		return kernels.dot(x[:n], y[:n])
+	}
+	var sums [2][2]float64
+	for k := 0; k < n; k++ {
+		re, im := mul64(x[k*incX], y[k*incY])
+		sums[k%2][0] += re
+		sums[k%2][1] += im
+	}
+	return xmath.NewComplex64(
+		float32(sums[0][0]+sums[1][0]),
+		float32(sums[0][1]+sums[1][1]))
+}
+
+// Dotc returns the sum of conj(a[i])*b[i] products.
+//
+// Products and partial sums are computed with float64 precision,
+// the result is rounded to Complex64 once.
+func Dotc(a, b []xmath.Complex64) xmath.Complex64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	return DotcInc(len(a), a, 1, b, 1)
+}
+
+// DotcInc is like Dotc, but operates on strided slices.
+func DotcInc(n int, x []xmath.Complex64, incX int, y []xmath.Complex64, incY int) xmath.Complex64 {
+	checkInc(n, x, incX)
+	checkInc(n, y, incY)
+	var re, im float64
+	for k := 0; k < n; k++ {
+		pr, pi := mul64(x[k*incX].Conj(), y[k*incY])
+		re += pr
+		im += pi
+	}
+	return xmath.NewComplex64(float32(re), float32(im))
+}
+
+// Axpy computes y[i] += alpha*x[i].
+//
+// The result is identical to y[i].MulAddAssign(alpha, x[i]).
+func Axpy(alpha xmath.Complex64, x, y []xmath.Complex64) {
+	if len(x) != len(y) {
+		panic(errLength)
+	}
+	kernels.axpy(alpha, x, y)
+}
+
+// AxpyInc is like Axpy, but operates on strided slices.
+func AxpyInc(n int, alpha xmath.Complex64, x []xmath.Complex64, incX int, y []xmath.Complex64, incY int) {
+	checkInc(n, x, incX)
+	checkInc(n, y, incY)
+	if incX == 1 && incY == 1 {
+		kernels.axpy(alpha, x[:n], y[:n])
+		return
+	}
+	for k := 0; k < n; k++ {
+		y[k*incY].MulAddAssign(alpha, x[k*incX])
+	}
+}
+
+// Sum returns the sum of x elements.
+//
+// Partial sums are computed with float64 precision,
+// the result is rounded to Complex64 once.
+func Sum(x []xmath.Complex64) xmath.Complex64 {
+	return SumInc(len(x), x, 1)
+}
+
+// SumInc is like Sum, but operates on a strided slice.
+func SumInc(n int, x []xmath.Complex64, incX int) xmath.Complex64 {
+	checkInc(n, x, incX)
+	var re, im float64
+	for k := 0; k < n; k++ {
+		re += float64(x[k*incX].Real())
+		im += float64(x[k*incX].Imag())
+	}
+	return xmath.NewComplex64(float32(re), float32(im))
+}
+
+// Nrm2 returns the Euclidean norm of x: sqrt(sum |x[i]|^2).
+//
+// LAPACK scnrm2 scales the values to avoid overflow of intermediate
+// float32 squares. Nrm2 accumulates squares in float64 instead:
+// a float64 can hold a sum of squares of any float32 values without
+// overflow or underflow, and the result is rounded only once.
+// The result overflows only if the norm itself is not
+// representable as float32.
+func Nrm2(x []xmath.Complex64) float32 {
+	return Nrm2Inc(len(x), x, 1)
+}
+
+// Nrm2Inc is like Nrm2, but operates on a strided slice.
+func Nrm2Inc(n int, x []xmath.Complex64, incX int) float32 {
+	checkInc(n, x, incX)
+	ssq := 0.0
+	for k := 0; k < n; k++ {
+		re := float64(x[k*incX].Real())
+		im := float64(x[k*incX].Imag())
+		ssq += re*re + im*im
+	}
+	return float32(math.Sqrt(ssq))
+}
+
+// Asum returns the sum of |real(x[i])| + |imag(x[i])|.
+//
+// This is the BLAS scasum definition, which is not the sum of
+// absolute values of complex numbers, but is cheaper to compute.
+func Asum(x []xmath.Complex64) float32 {
+	return AsumInc(len(x), x, 1)
+}
+
+// AsumInc is like Asum, but operates on a strided slice.
+func AsumInc(n int, x []xmath.Complex64, incX int) float32 {
+	checkInc(n, x, incX)
+	sum := 0.0
+	for k := 0; k < n; k++ {
+		sum += math.Abs(float64(x[k*incX].Real())) + math.Abs(float64(x[k*incX].Imag()))
+	}
+	return float32(sum)
+}
+
+// Iamax returns the index of the first element with the maximum
+// |real(x[i])| + |imag(x[i])| value, like BLAS icamax does.
+// Elements with NaN parts are never selected;
+// if all elements have NaN parts, 0 is returned.
+//
+// Iamax returns -1 for an empty slice.
+func Iamax(x []xmath.Complex64) int {
+	return IamaxInc(len(x), x, 1)
+}
+
+// IamaxInc is like Iamax, but operates on a strided slice.
+// The returned index k refers to the x[k*incX] element.
+func IamaxInc(n int, x []xmath.Complex64, incX int) int {
+	checkInc(n, x, incX)
+	if n == 0 {
+		return -1
+	}
+	idx := 0
+	maxSum := -1.0
+	for k := 0; k < n; k++ {
+		v := math.Abs(float64(x[k*incX].Real())) + math.Abs(float64(x[k*incX].Imag()))
+		if v > maxSum {
+			maxSum = v
+			idx = k
+		}
+	}
+	return idx
+}
@@ -0,0 +1,411 @@
+package vec
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+// Helper functions.
+
+// ttBigSum is an exact sum of float64 values.
+type ttBigSum struct {
+	sum big.Float
+}
+
+func (s *ttBigSum) add(x float64) {
+	var v big.Float
+	v.SetPrec(2048).SetFloat64(x)
+	s.sum.SetPrec(2048).Add(&s.sum, &v)
+}
+
+func (s *ttBigSum) addProduct(x, y float64) {
+	var v, w big.Float
+	v.SetPrec(2048).SetFloat64(x)
+	w.SetPrec(2048).SetFloat64(y)
+	v.Mul(&v, &w)
+	s.sum.SetPrec(2048).Add(&s.sum, &v)
+}
+
+func (s *ttBigSum) float64() float64 {
+	f, _ := s.sum.Float64()
+	return f
+}
+
+// ttBlasInput returns n finite values of moderate magnitudes.
+func ttBlasInput(seed uint64, n int) []xmath.Complex64 {
+	r := xrand.New(seed)
+	xs := make([]xmath.Complex64, n)
+	xrand.Fill(xs, func() xmath.Complex64 { return r.LogUniform(1e-3, 1e3) })
+	return xs
+}
+
+// ttCheckClose reports an error if have differs from the exact want value
+// by more than tol, plus the float32 rounding error.
+func ttCheckClose(t *testing.T, name string, have float32, want, tol float64) {
+	t.Helper()
+	tol += math.Abs(want) * 0x1p-24
+	if math.Abs(float64(have)-want) > tol {
+		t.Errorf("%s: error is too big;\nwant: %v\nhave: %v", name, want, have)
+	}
+}
+
+// ttStrided returns a slice that holds xs elements with inc stride.
+func ttStrided(xs []xmath.Complex64, inc int) []xmath.Complex64 {
+	res := make([]xmath.Complex64, (len(xs)-1)*inc+1)
+	for i := range res {
+		res[i] = xmath.NewComplex64(float32(math.NaN()), -1)
+	}
+	for k, x := range xs {
+		res[k*inc] = x
+	}
+	return res
+}
+
+// Unit tests.
+
+func TestDotReference(t *testing.T) {
+	const n = 5000
+	a := ttBlasInput(1, n)
+	b := ttBlasInput(2, n)
+
+	for _, conj := range []bool{false, true} {
+		var re, im ttBigSum
+		tol := 0.0
+		for i := range a {
+			ar, ai := float64(a[i].Real()), float64(a[i].Imag())
+			br, bi := float64(b[i].Real()), float64(b[i].Imag())
+			if conj {
+				ai = -ai
+			}
+			re.addProduct(ar, br)
+			re.addProduct(-ai, bi)
+			im.addProduct(ar, bi)
+			im.addProduct(ai, br)
+			tol += math.Abs(ar*br) + math.Abs(ai*bi) + math.Abs(ar*bi) + math.Abs(ai*br)
+		}
+		// Float64 summation error bound.
+		tol *= n * 0x1p-53
+
+		have := Dot(a, b)
+		name := "Dot"
+		if conj {
+			have = Dotc(a, b)
+			name = "Dotc"
+		}
+		ttCheckClose(t, name+" real", have.Real(), re.float64(), tol)
+		ttCheckClose(t, name+" imag", have.Imag(), im.float64(), tol)
+	}
+}
+
+func TestAxpy(t *testing.T) {
+	const n = 1000
+	x := ttInput(10, n)
+	y := ttInput(11, n)
+	alpha := xmath.NewComplex64(1.5, -0.25)
+
+	want := ttToBuiltin(y)
+	xBuiltin := ttToBuiltin(x)
+	alphaBuiltin := complex(alpha.Real(), alpha.Imag())
+	for i := range want {
+		want[i] += alphaBuiltin * xBuiltin[i]
+	}
+
+	Axpy(alpha, x, y)
+	ttCheck(t, "Axpy", y, want)
+}
+
+func TestSumReference(t *testing.T) {
+	const n = 5000
+	x := ttBlasInput(5, n)
+	var re, im ttBigSum
+	tol := 0.0
+	for _, v := range x {
+		re.add(float64(v.Real()))
+		im.add(float64(v.Imag()))
+		tol += float64(v.Abs())
+	}
+	tol *= n * 0x1p-53
+
+	have := Sum(x)
+	ttCheckClose(t, "Sum real", have.Real(), re.float64(), tol)
+	ttCheckClose(t, "Sum imag", have.Imag(), im.float64(), tol)
+}
+
+func TestNrm2(t *testing.T) {
+	huge := float32(1e30)
+	tiny := float32(1e-30)
+	tests := []struct {
+		x    []xmath.Complex64
+		want float64
+	}{
+		{nil, 0},
+		{[]xmath.Complex64{xmath.NewComplex64(3, 4)}, 5},
+		// Naive float32 computation overflows for these values.
+		{[]xmath.Complex64{xmath.NewComplex64(3*huge, 4*huge)}, 5e30},
+		{[]xmath.Complex64{xmath.NewComplex64(huge, huge), xmath.NewComplex64(huge, huge)}, 2e30},
+		// Naive float32 computation underflows for these values.
+		{[]xmath.Complex64{xmath.NewComplex64(3*tiny, 4*tiny)}, 5e-30},
+		{[]xmath.Complex64{xmath.NewComplex64(math.MaxFloat32, 0)}, math.MaxFloat32},
+	}
+
+	for _, tt := range tests {
+		ttCheckClose(t, "Nrm2", Nrm2(tt.x), tt.want, 0)
+	}
+
+	x := ttBlasInput(6, 5000)
+	var ssq ttBigSum
+	for _, v := range x {
+		ssq.addProduct(float64(v.Real()), float64(v.Real()))
+		ssq.addProduct(float64(v.Imag()), float64(v.Imag()))
+	}
+	var want big.Float
+	want.SetPrec(2048).Sqrt(&ssq.sum)
+	wantF, _ := want.Float64()
+	ttCheckClose(t, "Nrm2", Nrm2(x), wantF, 1e-12*wantF)
+}
+
+func TestAsumIamax(t *testing.T) {
+	x := ttInput(7, 1000)
+	wantSum := 0.0
+	wantIdx := -1
+	wantMax := -1.0
+	for i, v := range x {
+		s := math.Abs(float64(v.Real())) + math.Abs(float64(v.Imag()))
+		wantSum += s
+		if s > wantMax {
+			wantMax = s
+			wantIdx = i
+		}
+	}
+
+	if have := Asum(x); have != float32(wantSum) && !(have != have && wantSum != wantSum) {
+		t.Errorf("Asum mismatch;\nwant: %v\nhave: %v", wantSum, have)
+	}
+	if have := Iamax(x); have != wantIdx {
+		t.Errorf("Iamax mismatch;\nwant: %v\nhave: %v", wantIdx, have)
+	}
+
+	if have := Iamax(nil); have != -1 {
+		t.Errorf("Iamax(nil) mismatch;\nwant: -1\nhave: %v", have)
+	}
+	nan := float32(math.NaN())
+	tests := []struct {
+		x    []xmath.Complex64
+		want int
+	}{
+		{[]xmath.Complex64{xmath.NewComplex64(nan, 0), xmath.NewComplex64(1, 1)}, 1},
+		{[]xmath.Complex64{xmath.NewComplex64(1, 1), xmath.NewComplex64(-1, -1)}, 0},
+		{[]xmath.Complex64{xmath.NewComplex64(nan, 0), xmath.NewComplex64(0, nan)}, 0},
+	}
+	for _, tt := range tests {
+		if have := Iamax(tt.x); have != tt.want {
+			t.Errorf("Iamax(%v) mismatch;\nwant: %v\nhave: %v", tt.x, tt.want, have)
+		}
+	}
+}
+
+func TestInc(t *testing.T) {
+	const n = 101
+	x := ttBlasInput(8, n)
+	y := ttBlasInput(9, n)
+	alpha := xmath.NewComplex64(0.5, 2)
+	same := func(a, b xmath.Complex64) bool {
+		return ttSame(a, complex(b.Real(), b.Imag()))
+	}
+
+	for _, incX := range []int{1, 2, 3} {
+		for _, incY := range []int{1, 4} {
+			sx := ttStrided(x, incX)
+			sy := ttStrided(y, incY)
+
+			if have, want := DotInc(n, sx, incX, sy, incY), Dot(x, y); !same(have, want) {
+				t.Errorf("DotInc(%d, %d) mismatch;\nwant: %v\nhave: %v", incX, incY, want, have)
+			}
+			if have, want := DotcInc(n, sx, incX, sy, incY), Dotc(x, y); !same(have, want) {
+				t.Errorf("DotcInc(%d, %d) mismatch;\nwant: %v\nhave: %v", incX, incY, want, have)
+			}
+			if have, want := SumInc(n, sx, incX), Sum(x); !same(have, want) {
+				t.Errorf("SumInc(%d) mismatch;\nwant: %v\nhave: %v", incX, want, have)
+			}
+			if have, want := Nrm2Inc(n, sx, incX), Nrm2(x); have != want {
+				t.Errorf("Nrm2Inc(%d) mismatch;\nwant: %v\nhave: %v", incX, want, have)
+			}
+			if have, want := AsumInc(n, sx, incX), Asum(x); have != want {
+				t.Errorf("AsumInc(%d) mismatch;\nwant: %v\nhave: %v", incX, want, have)
+			}
+			if have, want := IamaxInc(n, sx, incX), Iamax(x); have != want {
+				t.Errorf("IamaxInc(%d) mismatch;\nwant: %v\nhave: %v", incX, want, have)
+			}
+
+			want := append([]xmath.Complex64(nil), y...)
+			Axpy(alpha, x, want)
+			AxpyInc(n, alpha, sx, incX, sy, incY)
+			for k := range want {
+				if !same(sy[k*incY], want[k]) {
+					t.Errorf("AxpyInc(%d, %d) [%d] mismatch;\nwant: %v\nhave: %v",
+						incX, incY, k, want[k], sy[k*incY])
+				}
+			}
+		}
+	}
+}
+
+func TestIncPanics(t *testing.T) {
+	x := make([]xmath.Complex64, 10)
+	tests := []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"zero inc", func() { SumInc(2, x, 0) }, errInc},
+		{"negative inc", func() { DotInc(2, x, 1, x, -1) }, errInc},
+		{"short x", func() { Nrm2Inc(4, x, 4) }, errShort},
+		{"zero incX", func() { AxpyInc(11, xmath.Complex64{}, x, 0, x, 1) }, errInc},
+		{"short y", func() { AxpyInc(6, xmath.Complex64{}, x, 1, x, 2) }, errShort},
+		{"negative n dot", func() { DotInc(-1, x, 1, x, 1) }, errCount},
+		{"negative n axpy", func() { AxpyInc(-3, xmath.Complex64{}, x, 1, x, 1) }, errCount},
+		{"negative n sum", func() { SumInc(-1, x, 2) }, errCount},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r != tt.want {
+					t.Errorf("%s: expected %q panic, got %v", tt.name, tt.want, r)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// Performance tests.
+
+var (
+	ttSink64   xmath.Complex64
+	ttSinkReal float32
+	ttSinkIdx  int
+)
+
+func benchBlasBuiltin(b *testing.B, fn func(x, y []complex64)) {
+	x := ttToBuiltin(ttBlasInput(1, 4096))
+	y := ttToBuiltin(ttBlasInput(2, 4096))
+	for i := 0; i < b.N; i++ {
+		fn(x, y)
+	}
+}
+
+func benchBlas(b *testing.B, fn func(x, y []xmath.Complex64)) {
+	x := ttBlasInput(1, 4096)
+	y := ttBlasInput(2, 4096)
+	for i := 0; i < b.N; i++ {
+		fn(x, y)
+	}
+}
+
+func BenchmarkDotBuiltin(b *testing.B) {
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		var acc complex64
+		for i := range x {
+			acc += x[i] * y[i]
+		}
+		ttSink64 = xmath.NewComplex64(real(acc), imag(acc))
+	})
+}
+
+func BenchmarkDot(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = Dot(x, y) })
+}
+
+func BenchmarkDotcBuiltin(b *testing.B) {
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		var acc complex64
+		for i := range x {
+			acc += complex(real(x[i]), -imag(x[i])) * y[i]
+		}
+		ttSink64 = xmath.NewComplex64(real(acc), imag(acc))
+	})
+}
+
+func BenchmarkDotc(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = Dotc(x, y) })
+}
+
+func BenchmarkAxpyBuiltin(b *testing.B) {
+	alpha := complex64(complex(0.5, 0.25))
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		for i := range x {
+			y[i] += alpha * x[i]
+		}
+	})
+}
+
+func BenchmarkAxpy(b *testing.B) {
+	alpha := xmath.NewComplex64(0.5, 0.25)
+	benchBlas(b, func(x, y []xmath.Complex64) { Axpy(alpha, x, y) })
+}
+
+func BenchmarkSumBuiltin(b *testing.B) {
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		var acc complex64
+		for _, v := range x {
+			acc += v
+		}
+		ttSink64 = xmath.NewComplex64(real(acc), imag(acc))
+	})
+}
+
+func BenchmarkSum(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = Sum(x) })
+}
+
+func BenchmarkNrm2Builtin(b *testing.B) {
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		var ssq float32
+		for _, v := range x {
+			ssq += real(v)*real(v) + imag(v)*imag(v)
+		}
+		ttSinkReal = float32(math.Sqrt(float64(ssq)))
+	})
+}
+
+func BenchmarkNrm2(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSinkReal = Nrm2(x) })
+}
+
+func BenchmarkAsumBuiltin(b *testing.B) {
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		var sum float32
+		for _, v := range x {
+			sum += float32(math.Abs(float64(real(v)))) + float32(math.Abs(float64(imag(v))))
+		}
+		ttSinkReal = sum
+	})
+}
+
+func BenchmarkAsum(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSinkReal = Asum(x) })
+}
+
+func BenchmarkIamaxBuiltin(b *testing.B) {
+	benchBlasBuiltin(b, func(x, y []complex64) {
+		idx := 0
+		maxSum := float32(-1)
+		for i, v := range x {
+			s := float32(math.Abs(float64(real(v)))) + float32(math.Abs(float64(imag(v))))
+			if s > maxSum {
+				maxSum = s
+				idx = i
+			}
+		}
+		ttSinkIdx = idx
+	})
+}
+
+func BenchmarkIamax(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSinkIdx = Iamax(x) })
+}
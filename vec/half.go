@@ -0,0 +1,51 @@
+package vec
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// Complex32To stores src values rounded to Complex32 into dst.
+func Complex32To(dst []xmath.Complex32, src []xmath.Complex64) []xmath.Complex32 {
+	if len(dst) != len(src) {
+		panic(errLength)
+	}
+	for i, x := range src {
+		dst[i] = x.Complex32()
+	}
+	return dst
+}
+
+// ComplexBF16To stores src values rounded to ComplexBF16 into dst.
+func ComplexBF16To(dst []xmath.ComplexBF16, src []xmath.Complex64) []xmath.ComplexBF16 {
+	if len(dst) != len(src) {
+		panic(errLength)
+	}
+	for i, x := range src {
+		dst[i] = x.ComplexBF16()
+	}
+	return dst
+}
+
+// WidenComplex32To stores src values converted to Complex64 into dst.
+// The conversion is exact.
+func WidenComplex32To(dst []xmath.Complex64, src []xmath.Complex32) []xmath.Complex64 {
+	if len(dst) != len(src) {
+		panic(errLength)
+	}
+	for i, x := range src {
+		dst[i] = x.Complex64()
+	}
+	return dst
+}
+
+// WidenComplexBF16To stores src values converted to Complex64 into dst.
+// The conversion is exact.
+func WidenComplexBF16To(dst []xmath.Complex64, src []xmath.ComplexBF16) []xmath.Complex64 {
+	if len(dst) != len(src) {
+		panic(errLength)
+	}
+	for i, x := range src {
+		dst[i] = x.Complex64()
+	}
+	return dst
+}
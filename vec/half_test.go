@@ -0,0 +1,49 @@
+package vec
+
+import (
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+func TestHalfConversions(t *testing.T) {
+	xs := ttInput(1, 1000)
+
+	halves := Complex32To(make([]xmath.Complex32, len(xs)), xs)
+	widened := WidenComplex32To(make([]xmath.Complex64, len(xs)), halves)
+	for i, x := range xs {
+		want := x.Complex32().Complex64()
+		if !ttSameComplex(widened[i], want) {
+			t.Errorf("Complex32 [%d] mismatch;\nwant: %v\nhave: %v", i, want, widened[i])
+		}
+	}
+
+	bf16s := ComplexBF16To(make([]xmath.ComplexBF16, len(xs)), xs)
+	widened = WidenComplexBF16To(make([]xmath.Complex64, len(xs)), bf16s)
+	for i, x := range xs {
+		want := x.ComplexBF16().Complex64()
+		if !ttSameComplex(widened[i], want) {
+			t.Errorf("ComplexBF16 [%d] mismatch;\nwant: %v\nhave: %v", i, want, widened[i])
+		}
+	}
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Complex32To", func() { Complex32To(make([]xmath.Complex32, 3), xs[:4]) }},
+		{"ComplexBF16To", func() { ComplexBF16To(make([]xmath.ComplexBF16, 5), xs[:4]) }},
+		{"WidenComplex32To", func() { WidenComplex32To(xs[:2], halves[:3]) }},
+		{"WidenComplexBF16To", func() { WidenComplexBF16To(xs[:3], bf16s[:2]) }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r != errLength {
+					t.Errorf("%s: expected %q panic, got %v", tt.name, errLength, r)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
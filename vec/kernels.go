@@ -0,0 +1,79 @@
+package vec
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// kernelSet is a group of low-level loop implementations.
+//
+// Kernels expect slices of equal length and do no bounds checking,
+// exported functions are responsible for the arguments validation.
+// All kernel sets must produce results that are bit-identical
+// to the generic kernels (except for the NaN payloads).
+type kernelSet struct {
+	name string
+	add  func(dst, a, b []xmath.Complex64)
+	mul  func(dst, a, b []xmath.Complex64)
+	axpy func(alpha xmath.Complex64, x, y []xmath.Complex64)
+	dot  func(a, b []xmath.Complex64) xmath.Complex64
+}
+
+// kernels is the fastest kernel set supported by the current CPU.
+var kernels = bestKernels()
+
+var genericKernels = kernelSet{
+	name: "generic",
+	add:  addGeneric,
+	mul:  mulGeneric,
+	axpy: axpyGeneric,
+	dot:  dotGeneric,
+}
+
+func addGeneric(dst, a, b []xmath.Complex64) {
+	for i := range dst {
+		dst[i] = a[i].Add(b[i])
+	}
+}
+
+func mulGeneric(dst, a, b []xmath.Complex64) {
+	for i := range dst {
+		dst[i] = a[i].Mul(b[i])
+	}
+}
+
+func axpyGeneric(alpha xmath.Complex64, x, y []xmath.Complex64) {
+	for i := range y {
+		y[i].MulAddAssign(alpha, x[i])
+	}
+}
+
+func dotGeneric(a, b []xmath.Complex64) xmath.Complex64 {
+	// Elements with even and odd indexes are accumulated separately,
+	// this is the order that SIMD implementations can reproduce.
+	var re0, im0, re1, im1 float64
+	i := 0
+	for ; i+1 < len(a); i += 2 {
+		re, im := mul64(a[i], b[i])
+		re0 += re
+		im0 += im
+		re, im = mul64(a[i+1], b[i+1])
+		re1 += re
+		im1 += im
+	}
+	if i < len(a) {
+		re, im := mul64(a[i], b[i])
+		re0 += re
+		im0 += im
+	}
+	return xmath.NewComplex64(float32(re0+re1), float32(im0+im1))
+}
+
+// mul64 returns x*y product computed like Complex64.Mul,
+// but without the final rounding to float32.
+func mul64(x, y xmath.Complex64) (re, im float64) {
+	r1 := float64(x.Real())
+	i1 := float64(x.Imag())
+	r2 := float64(y.Real())
+	i2 := float64(y.Imag())
+	return r1*r2 - i1*i2, r1*i2 + i1*r2
+}
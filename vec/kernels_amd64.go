@@ -0,0 +1,128 @@
+//go:build amd64 && !purego
+
+package vec
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// hasAVX2FMA reports whether both CPU and OS support AVX2 and FMA instructions.
+var hasAVX2FMA = detectAVX2FMA()
+
+func detectAVX2FMA() bool {
+	const (
+		fmaBit     = 1 << 12 // CPUID.1:ECX.
+		osxsaveBit = 1 << 27 // CPUID.1:ECX.
+		avxBit     = 1 << 28 // CPUID.1:ECX.
+		avx2Bit    = 1 << 5  // CPUID.(EAX=7,ECX=0):EBX.
+		xmmYmmMask = 0x6     // XCR0 bits that indicate OS support for XMM and YMM state.
+	)
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&(fmaBit|osxsaveBit|avxBit) != fmaBit|osxsaveBit|avxBit {
+		return false
+	}
+	if xcr0, _ := xgetbv(); xcr0&xmmYmmMask != xmmYmmMask {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&avx2Bit != 0
+}
+
+func bestKernels() kernelSet {
+	if hasAVX2FMA {
+		return avx2Kernels
+	}
+	return sse2Kernels
+}
+
+func availableKernels() []kernelSet {
+	sets := []kernelSet{genericKernels, sse2Kernels}
+	if hasAVX2FMA {
+		sets = append(sets, avx2Kernels)
+	}
+	return sets
+}
+
+var sse2Kernels = kernelSet{
+	name: "sse2",
+	add: func(dst, a, b []xmath.Complex64) {
+		if len(dst) != 0 {
+			addSSE2(&dst[0], &a[0], &b[0], len(dst))
+		}
+	},
+	mul: func(dst, a, b []xmath.Complex64) {
+		if len(dst) != 0 {
+			mulSSE2(&dst[0], &a[0], &b[0], len(dst))
+		}
+	},
+	axpy: func(alpha xmath.Complex64, x, y []xmath.Complex64) {
+		if len(y) != 0 {
+			axpySSE2(&alpha, &x[0], &y[0], len(y))
+		}
+	},
+	dot: func(a, b []xmath.Complex64) xmath.Complex64 {
+		if len(a) == 0 {
+			return xmath.Complex64{}
+		}
+		return xmath.NewComplex64(dotSSE2(&a[0], &b[0], len(a)))
+	},
+}
+
+var avx2Kernels = kernelSet{
+	name: "avx2",
+	add: func(dst, a, b []xmath.Complex64) {
+		if len(dst) != 0 {
+			addAVX2(&dst[0], &a[0], &b[0], len(dst))
+		}
+	},
+	mul: func(dst, a, b []xmath.Complex64) {
+		if len(dst) != 0 {
+			mulAVX2(&dst[0], &a[0], &b[0], len(dst))
+		}
+	},
+	axpy: func(alpha xmath.Complex64, x, y []xmath.Complex64) {
+		if len(y) != 0 {
+			axpyAVX2(&alpha, &x[0], &y[0], len(y))
+		}
+	},
+	dot: func(a, b []xmath.Complex64) xmath.Complex64 {
+		if len(a) == 0 {
+			return xmath.Complex64{}
+		}
+		return xmath.NewComplex64(dotAVX2(&a[0], &b[0], len(a)))
+	},
+}
+
+// Implemented in kernels_amd64.s.
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+//go:noescape
+func addSSE2(dst, a, b *xmath.Complex64, n int)
+
+//go:noescape
+func mulSSE2(dst, a, b *xmath.Complex64, n int)
+
+//go:noescape
+func axpySSE2(alpha, x, y *xmath.Complex64, n int)
+
+//go:noescape
+func dotSSE2(a, b *xmath.Complex64, n int) (re, im float32)
+
+//go:noescape
+func addAVX2(dst, a, b *xmath.Complex64, n int)
+
+//go:noescape
+func mulAVX2(dst, a, b *xmath.Complex64, n int)
+
+//go:noescape
+func axpyAVX2(alpha, x, y *xmath.Complex64, n int)
+
+//go:noescape
+func dotAVX2(a, b *xmath.Complex64, n int) (re, im float32)
@@ -0,0 +1,329 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// Complex multiplication is performed with float64 precision,
+// exactly like Complex64.Mul does it: products of float32 values are
+// exact in float64, so the only rounding happens during the final
+// add/sub and the conversion back to float32.
+// This makes FMA usage safe: it produces bit-identical results.
+
+// Sign mask that flips the sign of the low float64 lane.
+DATA negLo<>+0(SB)/8, $0x8000000000000000
+DATA negLo<>+8(SB)/8, $0
+GLOBL negLo<>(SB), RODATA|NOPTR, $16
+
+// MUL1 computes a single a*b product into X2 as
+// [ar*br - ai*bi, ar*bi + ai*br] float64 pair.
+// a and b are memory operands holding Complex64 values.
+// Clobbers X0, X1; X7 must hold negLo mask.
+#define MUL1(a, b) \
+	MOVQ     a, X0; \
+	MOVQ     b, X1; \
+	CVTPS2PD X0, X0; \
+	CVTPS2PD X1, X1; \
+	MOVAPD   X0, X2; \
+	UNPCKLPD X2, X2; \
+	UNPCKHPD X0, X0; \
+	MULPD    X1, X2; \
+	SHUFPD   $1, X1, X1; \
+	MULPD    X1, X0; \
+	XORPD    X7, X0; \
+	ADDPD    X0, X2
+
+// MUL2 computes two a*b products into Y3 as
+// [re0, im0, re1, im1] float64 values.
+// a and b are memory operands holding 2 Complex64 values each.
+// Clobbers Y0, Y1, Y2, Y4.
+//
+// Y2 holds real parts of a, Y3 holds imaginary parts of a,
+// Y4 holds b with swapped parts; VFMADDSUB subtracts
+// in even lanes and adds in odd lanes.
+#define MUL2(a, b) \
+	VCVTPS2PD      a, Y0; \
+	VCVTPS2PD      b, Y1; \
+	VMOVDDUP       Y0, Y2; \
+	VPERMILPD      $15, Y0, Y3; \
+	VPERMILPD      $5, Y1, Y4; \
+	VMULPD         Y4, Y3, Y3; \
+	VFMADDSUB231PD Y1, Y2, Y3
+
+// func addSSE2(dst, a, b *xmath.Complex64, n int)
+TEXT ·addSSE2(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), CX
+
+loop2:
+	CMPQ CX, $2
+	JL   tail
+	MOVUPS (SI), X0
+	MOVUPS (DX), X1
+	ADDPS  X1, X0
+	MOVUPS X0, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DX
+	ADDQ   $16, DI
+	SUBQ   $2, CX
+	JMP    loop2
+
+tail:
+	TESTQ CX, CX
+	JZ    done
+	MOVQ  (SI), X0
+	MOVQ  (DX), X1
+	ADDPS X1, X0
+	MOVQ  X0, (DI)
+
+done:
+	RET
+
+// func mulSSE2(dst, a, b *xmath.Complex64, n int)
+TEXT ·mulSSE2(SB), NOSPLIT, $0-32
+	MOVQ   dst+0(FP), DI
+	MOVQ   a+8(FP), SI
+	MOVQ   b+16(FP), DX
+	MOVQ   n+24(FP), CX
+	MOVUPS negLo<>(SB), X7
+
+loop:
+	TESTQ    CX, CX
+	JZ       done
+	MUL1((SI), (DX))
+	CVTPD2PS X2, X2
+	MOVQ     X2, (DI)
+	ADDQ     $8, SI
+	ADDQ     $8, DX
+	ADDQ     $8, DI
+	DECQ     CX
+	JMP      loop
+
+done:
+	RET
+
+// func axpySSE2(alpha, x, y *xmath.Complex64, n int)
+TEXT ·axpySSE2(SB), NOSPLIT, $0-32
+	MOVQ   alpha+0(FP), AX
+	MOVQ   x+8(FP), SI
+	MOVQ   y+16(FP), DI
+	MOVQ   n+24(FP), CX
+	MOVUPS negLo<>(SB), X7
+
+loop:
+	TESTQ    CX, CX
+	JZ       done
+	MUL1((AX), (SI))
+	CVTPD2PS X2, X2
+	MOVQ     (DI), X3
+	ADDPS    X2, X3
+	MOVQ     X3, (DI)
+	ADDQ     $8, SI
+	ADDQ     $8, DI
+	DECQ     CX
+	JMP      loop
+
+done:
+	RET
+
+// func dotSSE2(a, b *xmath.Complex64, n int) (re, im float32)
+TEXT ·dotSSE2(SB), NOSPLIT, $0-32
+	MOVQ   a+0(FP), SI
+	MOVQ   b+8(FP), DX
+	MOVQ   n+16(FP), CX
+	MOVUPS negLo<>(SB), X7
+	XORPD  X10, X10 // Even elements sum.
+	XORPD  X11, X11 // Odd elements sum.
+
+loop2:
+	CMPQ  CX, $2
+	JL    tail
+	MUL1((SI), (DX))
+	ADDPD X2, X10
+	MUL1(8(SI), 8(DX))
+	ADDPD X2, X11
+	ADDQ  $16, SI
+	ADDQ  $16, DX
+	SUBQ  $2, CX
+	JMP   loop2
+
+tail:
+	TESTQ CX, CX
+	JZ    done
+	MUL1((SI), (DX))
+	ADDPD X2, X10
+
+done:
+	ADDPD    X11, X10
+	CVTPD2PS X10, X10
+	MOVSS    X10, re+24(FP)
+	PSRLQ    $32, X10
+	MOVSS    X10, im+28(FP)
+	RET
+
+// func addAVX2(dst, a, b *xmath.Complex64, n int)
+TEXT ·addAVX2(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), CX
+
+loop4:
+	CMPQ    CX, $4
+	JL      tail
+	VMOVUPS (SI), Y0
+	VADDPS  (DX), Y0, Y0
+	VMOVUPS Y0, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DX
+	ADDQ    $32, DI
+	SUBQ    $4, CX
+	JMP     loop4
+
+tail:
+	VZEROUPPER
+
+tailLoop:
+	TESTQ CX, CX
+	JZ    done
+	MOVQ  (SI), X0
+	MOVQ  (DX), X1
+	ADDPS X1, X0
+	MOVQ  X0, (DI)
+	ADDQ  $8, SI
+	ADDQ  $8, DX
+	ADDQ  $8, DI
+	DECQ  CX
+	JMP   tailLoop
+
+done:
+	RET
+
+// func mulAVX2(dst, a, b *xmath.Complex64, n int)
+TEXT ·mulAVX2(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), CX
+
+loop2:
+	CMPQ       CX, $2
+	JL         tail
+	MUL2((SI), (DX))
+	VCVTPD2PSY Y3, X3
+	VMOVUPS    X3, (DI)
+	ADDQ       $16, SI
+	ADDQ       $16, DX
+	ADDQ       $16, DI
+	SUBQ       $2, CX
+	JMP        loop2
+
+tail:
+	VZEROUPPER
+	TESTQ    CX, CX
+	JZ       done
+	MOVUPS   negLo<>(SB), X7
+	MUL1((SI), (DX))
+	CVTPD2PS X2, X2
+	MOVQ     X2, (DI)
+
+done:
+	RET
+
+// func axpyAVX2(alpha, x, y *xmath.Complex64, n int)
+TEXT ·axpyAVX2(SB), NOSPLIT, $0-32
+	MOVQ alpha+0(FP), AX
+	MOVQ x+8(FP), SI
+	MOVQ y+16(FP), DI
+	MOVQ n+24(FP), CX
+
+	// Y8 = [ar, ar, ar, ar], Y9 = [ai, ai, ai, ai].
+	VMOVQ       (AX), X0
+	VCVTPS2PD   X0, X0
+	VMOVDDUP    X0, X8
+	VPERMILPD   $3, X0, X9
+	VINSERTF128 $1, X8, Y8, Y8
+	VINSERTF128 $1, X9, Y9, Y9
+
+loop2:
+	CMPQ           CX, $2
+	JL             tail
+	VCVTPS2PD      (SI), Y1   // [xr0, xi0, xr1, xi1]
+	VPERMILPD      $5, Y1, Y4 // [xi0, xr0, xi1, xr1]
+	VMULPD         Y4, Y9, Y3 // [ai*xi, ai*xr, ...]
+	VFMADDSUB231PD Y1, Y8, Y3 // [ar*xr - ai*xi, ar*xi + ai*xr, ...]
+	VCVTPD2PSY     Y3, X3
+	VMOVUPS        (DI), X5
+	VADDPS         X3, X5, X3
+	VMOVUPS        X3, (DI)
+	ADDQ           $16, SI
+	ADDQ           $16, DI
+	SUBQ           $2, CX
+	JMP            loop2
+
+tail:
+	VZEROUPPER
+	TESTQ    CX, CX
+	JZ       done
+	MOVUPS   negLo<>(SB), X7
+	MUL1((AX), (SI))
+	CVTPD2PS X2, X2
+	MOVQ     (DI), X3
+	ADDPS    X2, X3
+	MOVQ     X3, (DI)
+
+done:
+	RET
+
+// func dotAVX2(a, b *xmath.Complex64, n int) (re, im float32)
+TEXT ·dotAVX2(SB), NOSPLIT, $0-32
+	MOVQ   a+0(FP), SI
+	MOVQ   b+8(FP), DX
+	MOVQ   n+16(FP), CX
+	VXORPD Y10, Y10, Y10 // [even re, even im, odd re, odd im] sums.
+
+loop2:
+	CMPQ   CX, $2
+	JL     tail
+	MUL2((SI), (DX))
+	VADDPD Y3, Y10, Y10
+	ADDQ   $16, SI
+	ADDQ   $16, DX
+	SUBQ   $2, CX
+	JMP    loop2
+
+tail:
+	VEXTRACTF128 $1, Y10, X11
+	VZEROUPPER
+	TESTQ        CX, CX
+	JZ           done
+	MOVUPS       negLo<>(SB), X7
+	MUL1((SI), (DX))
+	ADDPD        X2, X10
+
+done:
+	ADDPD    X11, X10
+	CVTPD2PS X10, X10
+	MOVSS    X10, re+24(FP)
+	PSRLQ    $32, X10
+	MOVSS    X10, im+28(FP)
+	RET
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
@@ -0,0 +1,9 @@
+//go:build !amd64 || purego
+
+package vec
+
+func bestKernels() kernelSet { return genericKernels }
+
+func availableKernels() []kernelSet {
+	return []kernelSet{genericKernels}
+}
@@ -0,0 +1,122 @@
+package vec
+
+import (
+	"fmt"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+func ttSameComplex(x, y xmath.Complex64) bool {
+	return ttSame(x, complex(y.Real(), y.Imag()))
+}
+
+func TestKernelsBitIdentical(t *testing.T) {
+	// Lengths are chosen to cover both SIMD loops and all tail cases.
+	lengths := []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 15, 16, 17, 100, 1001}
+
+	for _, ks := range availableKernels() {
+		for _, n := range lengths {
+			a := ttInput(uint64(n), n)
+			b := ttInput(uint64(n)+1000, n)
+			alphas := ttInput(uint64(n)+2000, 8)
+
+			want := make([]xmath.Complex64, n)
+			have := make([]xmath.Complex64, n)
+
+			genericKernels.add(want, a, b)
+			ks.add(have, a, b)
+			for i := range want {
+				if !ttSameComplex(have[i], want[i]) {
+					t.Errorf("%s: add n=%d [%d]: %v+%v;\nwant: %v\nhave: %v",
+						ks.name, n, i, a[i], b[i], want[i], have[i])
+				}
+			}
+
+			genericKernels.mul(want, a, b)
+			ks.mul(have, a, b)
+			for i := range want {
+				if !ttSameComplex(have[i], want[i]) {
+					t.Errorf("%s: mul n=%d [%d]: %v*%v;\nwant: %v\nhave: %v",
+						ks.name, n, i, a[i], b[i], want[i], have[i])
+				}
+				if scalar := a[i].Mul(b[i]); !ttSameComplex(have[i], scalar) {
+					t.Errorf("%s: mul n=%d [%d]: differs from Complex64.Mul", ks.name, n, i)
+				}
+			}
+
+			for _, alpha := range alphas {
+				copy(want, b)
+				copy(have, b)
+				genericKernels.axpy(alpha, a, want)
+				ks.axpy(alpha, a, have)
+				for i := range want {
+					if !ttSameComplex(have[i], want[i]) {
+						t.Errorf("%s: axpy n=%d [%d]: %v*%v+%v;\nwant: %v\nhave: %v",
+							ks.name, n, i, alpha, a[i], b[i], want[i], have[i])
+					}
+				}
+			}
+
+			// Special values make most sums NaN, so dot is also
+			// checked over the finite values only.
+			for _, input := range [][2][]xmath.Complex64{{a, b}, ttFinite(a, b)} {
+				want := genericKernels.dot(input[0], input[1])
+				have := ks.dot(input[0], input[1])
+				if !ttSameComplex(have, want) {
+					t.Errorf("%s: dot n=%d;\nwant: %v\nhave: %v",
+						ks.name, len(input[0]), want, have)
+				}
+			}
+		}
+	}
+}
+
+// ttFinite returns a and b elements pairs that have no NaN or Inf parts.
+func ttFinite(a, b []xmath.Complex64) [2][]xmath.Complex64 {
+	finite := func(x xmath.Complex64) bool {
+		return x.Sub(x).IsZero()
+	}
+	var res [2][]xmath.Complex64
+	for i := range a {
+		if finite(a[i]) && finite(b[i]) {
+			res[0] = append(res[0], a[i])
+			res[1] = append(res[1], b[i])
+		}
+	}
+	return res
+}
+
+func BenchmarkKernels(b *testing.B) {
+	const n = 4096
+	x := ttInput(1, n)
+	y := ttInput(2, n)
+	dst := make([]xmath.Complex64, n)
+	alpha := xmath.NewComplex64(0.5, 0.5)
+
+	for _, ks := range availableKernels() {
+		b.Run(fmt.Sprintf("add/%s", ks.name), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ks.add(dst, x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("mul/%s", ks.name), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ks.mul(dst, x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("axpy/%s", ks.name), func(b *testing.B) {
+			copy(dst, y)
+			for i := 0; i < b.N; i++ {
+				ks.axpy(alpha, x, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("dot/%s", ks.name), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ttDot = ks.dot(x, y)
+			}
+		})
+	}
+}
+
+var ttDot xmath.Complex64
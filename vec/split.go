@@ -0,0 +1,187 @@
+package vec
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// SplitComplex64s is a slice of complex numbers that stores
+// real and imaginary parts in separate slices
+// (also known as "structure of arrays" layout).
+//
+// This layout is more suitable for SIMD processing than
+// the interleaved []Complex64, but it can't be expressed
+// with builtin complex types at all.
+//
+// Re and Im must have equal lengths.
+type SplitComplex64s struct {
+	Re []float32
+	Im []float32
+}
+
+// MakeSplit returns a zeroed SplitComplex64s of length n.
+func MakeSplit(n int) SplitComplex64s {
+	return SplitComplex64s{
+		Re: make([]float32, n),
+		Im: make([]float32, n),
+	}
+}
+
+// Len returns the number of complex values stored in s.
+func (s SplitComplex64s) Len() int { return len(s.Re) }
+
+// At returns i-th complex value.
+func (s SplitComplex64s) At(i int) xmath.Complex64 {
+	return xmath.NewComplex64(s.Re[i], s.Im[i])
+}
+
+// Set assigns x to i-th complex value.
+func (s SplitComplex64s) Set(i int, x xmath.Complex64) {
+	s.Re[i] = x.Real()
+	s.Im[i] = x.Imag()
+}
+
+// Slice returns s[i:j] view that shares the storage with s.
+func (s SplitComplex64s) Slice(i, j int) SplitComplex64s {
+	return SplitComplex64s{Re: s.Re[i:j], Im: s.Im[i:j]}
+}
+
+// SplitTo stores src values into dst.
+func SplitTo(dst SplitComplex64s, src []xmath.Complex64) SplitComplex64s {
+	checkSplit(dst, len(src))
+	for i, x := range src {
+		dst.Re[i] = x.Real()
+		dst.Im[i] = x.Imag()
+	}
+	return dst
+}
+
+// SplitBuiltinTo stores src values into dst.
+func SplitBuiltinTo(dst SplitComplex64s, src []complex64) SplitComplex64s {
+	checkSplit(dst, len(src))
+	for i, x := range src {
+		dst.Re[i] = real(x)
+		dst.Im[i] = imag(x)
+	}
+	return dst
+}
+
+// InterleaveTo stores src values into dst.
+func InterleaveTo(dst []xmath.Complex64, src SplitComplex64s) []xmath.Complex64 {
+	checkSplit(src, len(dst))
+	for i := range dst {
+		dst[i] = xmath.NewComplex64(src.Re[i], src.Im[i])
+	}
+	return dst
+}
+
+// InterleaveBuiltinTo stores src values into dst.
+func InterleaveBuiltinTo(dst []complex64, src SplitComplex64s) []complex64 {
+	checkSplit(src, len(dst))
+	for i := range dst {
+		dst[i] = complex(src.Re[i], src.Im[i])
+	}
+	return dst
+}
+
+// SplitAddTo computes dst[i] = a[i] + b[i].
+func SplitAddTo(dst, a, b SplitComplex64s) SplitComplex64s {
+	n := dst.Len()
+	checkSplit(dst, n)
+	checkSplit(a, n)
+	checkSplit(b, n)
+	for i := range dst.Re {
+		dst.Re[i] = a.Re[i] + b.Re[i]
+	}
+	for i := range dst.Im {
+		dst.Im[i] = a.Im[i] + b.Im[i]
+	}
+	return dst
+}
+
+// SplitSubTo computes dst[i] = a[i] - b[i].
+func SplitSubTo(dst, a, b SplitComplex64s) SplitComplex64s {
+	n := dst.Len()
+	checkSplit(dst, n)
+	checkSplit(a, n)
+	checkSplit(b, n)
+	for i := range dst.Re {
+		dst.Re[i] = a.Re[i] - b.Re[i]
+	}
+	for i := range dst.Im {
+		dst.Im[i] = a.Im[i] - b.Im[i]
+	}
+	return dst
+}
+
+// SplitMulTo computes dst[i] = a[i] * b[i].
+//
+// The result is identical to Complex64.Mul.
+func SplitMulTo(dst, a, b SplitComplex64s) SplitComplex64s {
+	n := dst.Len()
+	checkSplit(dst, n)
+	checkSplit(a, n)
+	checkSplit(b, n)
+	// Re-slicing helps the compiler to eliminate bounds checks.
+	ar, ai := a.Re[:n], a.Im[:n]
+	br, bi := b.Re[:n], b.Im[:n]
+	dr, di := dst.Re[:n], dst.Im[:n]
+	for i := range dr {
+		r1 := float64(ar[i])
+		i1 := float64(ai[i])
+		r2 := float64(br[i])
+		i2 := float64(bi[i])
+		dr[i] = float32(r1*r2 - i1*i2)
+		di[i] = float32(r1*i2 + i1*r2)
+	}
+	return dst
+}
+
+// SplitDivTo computes dst[i] = a[i] / b[i].
+//
+// The result is identical to Complex64.Div.
+func SplitDivTo(dst, a, b SplitComplex64s) SplitComplex64s {
+	n := dst.Len()
+	checkSplit(dst, n)
+	checkSplit(a, n)
+	checkSplit(b, n)
+	for i := 0; i < n; i++ {
+		dst.Set(i, a.At(i).Div(b.At(i)))
+	}
+	return dst
+}
+
+// SplitScaleTo computes dst[i] = s * a[i].
+func SplitScaleTo(dst SplitComplex64s, s xmath.Complex64, a SplitComplex64s) SplitComplex64s {
+	n := dst.Len()
+	checkSplit(dst, n)
+	checkSplit(a, n)
+	sr, si := float64(s.Real()), float64(s.Imag())
+	ar, ai := a.Re[:n], a.Im[:n]
+	dr, di := dst.Re[:n], dst.Im[:n]
+	for i := range dr {
+		r2 := float64(ar[i])
+		i2 := float64(ai[i])
+		dr[i] = float32(sr*r2 - si*i2)
+		di[i] = float32(sr*i2 + si*r2)
+	}
+	return dst
+}
+
+// SplitConjTo computes dst[i] = conj(a[i]).
+func SplitConjTo(dst, a SplitComplex64s) SplitComplex64s {
+	n := dst.Len()
+	checkSplit(dst, n)
+	checkSplit(a, n)
+	copy(dst.Re, a.Re)
+	for i := range dst.Im {
+		dst.Im[i] = -a.Im[i]
+	}
+	return dst
+}
+
+// checkSplit panics if s parts lengths are not equal to n.
+func checkSplit(s SplitComplex64s, n int) {
+	if len(s.Re) != n || len(s.Im) != n {
+		panic(errLength)
+	}
+}
@@ -0,0 +1,128 @@
+package vec
+
+import (
+	"fmt"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+func ttSplit(xs []xmath.Complex64) SplitComplex64s {
+	return SplitTo(MakeSplit(len(xs)), xs)
+}
+
+func TestSplitConversions(t *testing.T) {
+	xs := ttInput(1, 100)
+	s := ttSplit(xs)
+	if s.Len() != len(xs) {
+		t.Fatalf("Len mismatch: want %d, have %d", len(xs), s.Len())
+	}
+
+	ttCheck(t, "InterleaveTo", InterleaveTo(make([]xmath.Complex64, len(xs)), s), ttToBuiltin(xs))
+
+	builtin := ttToBuiltin(xs)
+	s2 := SplitBuiltinTo(MakeSplit(len(builtin)), builtin)
+	have := InterleaveBuiltinTo(make([]complex64, len(xs)), s2)
+	for i := range have {
+		if !ttSame(s.At(i), have[i]) {
+			t.Errorf("builtin round trip [%d] mismatch;\nwant: %v\nhave: %v", i, s.At(i), have[i])
+		}
+	}
+
+	sub := s.Slice(10, 20)
+	sub.Set(0, xmath.NewComplex64(1, 2))
+	if s.At(10) != xmath.NewComplex64(1, 2) {
+		t.Errorf("Slice doesn't share the storage")
+	}
+}
+
+func TestSplitOps(t *testing.T) {
+	const n = 1000
+	a := ttInput(1, n)
+	b := ttInput(2, n)
+	sa := ttSplit(a)
+	sb := ttSplit(b)
+	scale := xmath.NewComplex64(-3, 0.5)
+
+	tests := []struct {
+		name  string
+		op    func(dst, a, b []xmath.Complex64) []xmath.Complex64
+		split func(dst, a, b SplitComplex64s) SplitComplex64s
+	}{
+		{"Add", AddTo, SplitAddTo},
+		{"Sub", SubTo, SplitSubTo},
+		{"Mul", MulTo, SplitMulTo},
+		{"Div", DivTo, SplitDivTo},
+		{
+			"Scale",
+			func(dst, a, b []xmath.Complex64) []xmath.Complex64 { return ScaleTo(dst, scale, a) },
+			func(dst, a, b SplitComplex64s) SplitComplex64s { return SplitScaleTo(dst, scale, a) },
+		},
+		{
+			"Conj",
+			func(dst, a, b []xmath.Complex64) []xmath.Complex64 { return ConjTo(dst, a) },
+			func(dst, a, b SplitComplex64s) SplitComplex64s { return SplitConjTo(dst, a) },
+		},
+	}
+
+	for _, tt := range tests {
+		want := tt.op(make([]xmath.Complex64, n), a, b)
+		have := tt.split(MakeSplit(n), sa, sb)
+		for i := range want {
+			if !ttSame(have.At(i), complex(want[i].Real(), want[i].Imag())) {
+				t.Errorf("%s: [%d] mismatch;\nwant: %v\nhave: %v", tt.name, i, want[i], have.At(i))
+			}
+		}
+	}
+}
+
+func TestSplitLengthMismatch(t *testing.T) {
+	a := MakeSplit(4)
+	b := MakeSplit(5)
+	bad := SplitComplex64s{Re: make([]float32, 4), Im: make([]float32, 3)}
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"SplitAddTo", func() { SplitAddTo(a, a, b) }},
+		{"SplitMulTo", func() { SplitMulTo(a, bad, a) }},
+		{"SplitTo", func() { SplitTo(a, make([]xmath.Complex64, 5)) }},
+		{"InterleaveTo", func() { InterleaveTo(make([]xmath.Complex64, 4), bad) }},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r != errLength {
+					t.Errorf("%s: expected %q panic, got %v", tt.name, errLength, r)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// AoS vs SoA benchmarks.
+// Compare with BenchmarkMulTo and BenchmarkDivTo results.
+
+func benchSplit(b *testing.B, op func(dst, x, y SplitComplex64s) SplitComplex64s) {
+	for _, n := range ttBenchSizes {
+		x := ttSplit(ttInput(1, n))
+		y := ttSplit(ttInput(2, n))
+		dst := MakeSplit(n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n) * 8)
+			for i := 0; i < b.N; i++ {
+				op(dst, x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkSplitMulTo(b *testing.B) {
+	benchSplit(b, SplitMulTo)
+}
+
+func BenchmarkSplitDivTo(b *testing.B) {
+	benchSplit(b, SplitDivTo)
+}
@@ -0,0 +1,161 @@
+package vec
+
+import (
+	"math"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// This file implements compensated summation algorithms.
+//
+// Unlike Sum and Dot, which accumulate in float64, these functions
+// use only float32 arithmetic, like a naive builtin complex64 loop does,
+// and recover the lost precision with error-free transformations.
+// Every part is summed independently.
+
+// pairwiseBlock is the length of the naive summation
+// base case in PairwiseSum.
+const pairwiseBlock = 32
+
+// KahanSum returns the sum of x elements computed with
+// Kahan compensated summation.
+//
+// The error bound is 2u*sum|x[i]| (u = 2^-24), independent of
+// len(x) for len(x) < 2^24. Summands bigger than the running sum
+// lose their compensation; use NeumaierSum for such inputs.
+func KahanSum(x []xmath.Complex64) xmath.Complex64 {
+	var re, im kahan
+	for _, v := range x {
+		re.add(v.Real())
+		im.add(v.Imag())
+	}
+	return xmath.NewComplex64(re.sum, im.sum)
+}
+
+// NeumaierSum returns the sum of x elements computed with
+// Neumaier's improvement of Kahan summation that also handles
+// summands bigger than the running sum.
+func NeumaierSum(x []xmath.Complex64) xmath.Complex64 {
+	var re, im neumaier
+	for _, v := range x {
+		re.add(v.Real())
+		im.add(v.Imag())
+	}
+	return xmath.NewComplex64(re.result(), im.result())
+}
+
+// PairwiseSum returns the sum of x elements computed with
+// pairwise (cascade) summation.
+//
+// It's almost as fast as the naive loop, but the error
+// grows as O(log(len(x))) instead of O(len(x)).
+func PairwiseSum(x []xmath.Complex64) xmath.Complex64 {
+	if len(x) <= pairwiseBlock {
+		var re, im float32
+		for _, v := range x {
+			re += v.Real()
+			im += v.Imag()
+		}
+		return xmath.NewComplex64(re, im)
+	}
+	m := len(x) / 2
+	return PairwiseSum(x[:m]).Add(PairwiseSum(x[m:]))
+}
+
+// CompensatedDot returns the sum of a[i]*b[i] products computed
+// with float32 arithmetic as if it was twice as precise
+// (Ogita, Rump and Oishi Dot2 algorithm).
+//
+// Products are split with TwoProduct (FMA), sums with TwoSum;
+// error terms are accumulated separately and added at the end.
+func CompensatedDot(a, b []xmath.Complex64) xmath.Complex64 {
+	if len(a) != len(b) {
+		panic(errLength)
+	}
+	var re, im dot2
+	for i := range a {
+		ar, ai := a[i].Real(), a[i].Imag()
+		br, bi := b[i].Real(), b[i].Imag()
+		re.add(ar, br)
+		re.add(-ai, bi)
+		im.add(ar, bi)
+		im.add(ai, br)
+	}
+	return xmath.NewComplex64(re.result(), im.result())
+}
+
+// kahan is a Kahan summation state.
+type kahan struct {
+	sum float32
+	c   float32
+}
+
+func (k *kahan) add(x float32) {
+	y := x - k.c
+	t := k.sum + y
+	k.c = (t - k.sum) - y
+	k.sum = t
+}
+
+// neumaier is a Neumaier summation state.
+type neumaier struct {
+	sum float32
+	c   float32
+}
+
+func (n *neumaier) add(x float32) {
+	t := n.sum + x
+	if abs32(n.sum) >= abs32(x) {
+		n.c += (n.sum - t) + x
+	} else {
+		n.c += (x - t) + n.sum
+	}
+	n.sum = t
+}
+
+func (n *neumaier) result() float32 {
+	return n.sum + n.c
+}
+
+// dot2 is a Dot2 algorithm state.
+type dot2 struct {
+	sum float32
+	c   float32
+}
+
+func (d *dot2) add(x, y float32) {
+	p, ep := twoProduct(x, y)
+	s, es := twoSum(d.sum, p)
+	d.sum = s
+	d.c += ep + es
+}
+
+func (d *dot2) result() float32 {
+	return d.sum + d.c
+}
+
+// twoSum returns s = fl(a+b) and the exact error e = a+b-s.
+func twoSum(a, b float32) (s, e float32) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+	return s, e
+}
+
+// twoProduct returns p = fl(a*b) and the error e = a*b-p.
+//
+// The error is exact unless |a*b| < 2^-102, where it may be
+// finer than the smallest float32 subnormal and is rounded,
+// or p overflows, where e is -p.
+func twoProduct(a, b float32) (p, e float32) {
+	// The explicit conversion forbids fusing the product.
+	p = float32(a * b)
+	// FMA computes a*b-p with a single rounding to float64,
+	// which is exact for float32 a, b and p.
+	e = float32(math.FMA(float64(a), float64(b), -float64(p)))
+	return p, e
+}
+
+func abs32(x float32) float32 {
+	return math.Float32frombits(math.Float32bits(x) &^ (1 << 31))
+}
@@ -0,0 +1,238 @@
+package vec
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+// Helper functions.
+
+// ttPairwiseBound returns the relative error bound of PairwiseSum
+// for n >= pairwiseBlock summands: the naive base case adds up to
+// pairwiseBlock-1 roundings, every level of the recursion adds one.
+func ttPairwiseBound(n int) float64 {
+	const u = 0x1p-24
+	return (pairwiseBlock - 1 + math.Log2(float64(n)/pairwiseBlock)) * u
+}
+
+// ttNaiveSum sums x with float32 accumulators like a builtin loop does.
+func ttNaiveSum(x []xmath.Complex64) xmath.Complex64 {
+	var acc complex64
+	for _, v := range x {
+		acc += complex(v.Real(), v.Imag())
+	}
+	return xmath.NewComplex64(real(acc), imag(acc))
+}
+
+// ttNaiveDot is a builtin complex64 dot product loop.
+func ttNaiveDot(a, b []xmath.Complex64) xmath.Complex64 {
+	var acc complex64
+	for i := range a {
+		acc += complex(a[i].Real(), a[i].Imag()) * complex(b[i].Real(), b[i].Imag())
+	}
+	return xmath.NewComplex64(real(acc), imag(acc))
+}
+
+// ttExactSum returns exact sums of x parts and sums of their magnitudes.
+func ttExactSum(x []xmath.Complex64) (re, im, absRe, absIm float64) {
+	var sr, si ttBigSum
+	for _, v := range x {
+		sr.add(float64(v.Real()))
+		si.add(float64(v.Imag()))
+		absRe += math.Abs(float64(v.Real()))
+		absIm += math.Abs(float64(v.Imag()))
+	}
+	return sr.float64(), si.float64(), absRe, absIm
+}
+
+// ttIllConditioned returns n values with sum of magnitudes
+// much bigger than the magnitude of their sum.
+func ttIllConditioned(seed uint64, n int, cond float32) []xmath.Complex64 {
+	xs := ttBlasInput(seed, n)
+	r := xrand.New(seed + 1)
+	// Big values cancel each other exactly, so only
+	// the small ones contribute to the sum.
+	for i := 0; i+1 < n; i += 4 {
+		big := r.Disk(cond)
+		xs[i] = big
+		xs[i+1] = xmath.NewComplex64(0, 0).Sub(big)
+	}
+	rng := rand.New(rand.NewPCG(seed, 2))
+	rng.Shuffle(len(xs), func(i, j int) { xs[i], xs[j] = xs[j], xs[i] })
+	return xs
+}
+
+// ttSumError returns the largest relative part error of have
+// with respect to the exact sum.
+func ttSumError(have xmath.Complex64, re, im float64) float64 {
+	rel := func(have float32, want float64) float64 {
+		if want == 0 {
+			return math.Abs(float64(have))
+		}
+		return math.Abs(float64(have)-want) / math.Abs(want)
+	}
+	return max(rel(have.Real(), re), rel(have.Imag(), im))
+}
+
+// Unit tests.
+
+func TestCompensatedSumGrowth(t *testing.T) {
+	const u = 0x1p-24
+	for _, n := range []int{100, 10000, 1000000} {
+		xs := ttBlasInput(uint64(n), n)
+		for i, x := range xs {
+			xs[i] = xmath.NewComplex64(abs32(x.Real()), abs32(x.Imag()))
+		}
+		re, im, _, _ := ttExactSum(xs)
+
+		naive := ttSumError(ttNaiveSum(xs), re, im)
+		kahan := ttSumError(KahanSum(xs), re, im)
+		neumaier := ttSumError(NeumaierSum(xs), re, im)
+		pairwise := ttSumError(PairwiseSum(xs), re, im)
+		t.Logf("n=%7d: naive %.1eu, pairwise %.1eu, kahan %.1eu, neumaier %.1eu",
+			n, naive/u, pairwise/u, kahan/u, neumaier/u)
+
+		// All values are positive, so the sum is well-conditioned
+		// and relative bounds are the same as absolute ones.
+		if nu := float64(n) * u; kahan > 2*u+2*nu*u || neumaier > u+nu*nu {
+			t.Errorf("n=%d: compensated error is too big: kahan %g, neumaier %g", n, kahan, neumaier)
+		}
+		if bound := ttPairwiseBound(n); pairwise > bound {
+			t.Errorf("n=%d: pairwise error %g is bigger than %g", n, pairwise, bound)
+		}
+		if n >= 10000 && naive < 10*max(kahan, pairwise) {
+			t.Errorf("n=%d: naive summation is suspiciously precise: %g", n, naive)
+		}
+	}
+}
+
+func TestCompensatedSumIllConditioned(t *testing.T) {
+	const u = 0x1p-24
+	for _, cond := range []float32{1e3, 1e5, 1e7} {
+		xs := ttIllConditioned(uint64(cond), 10000, cond)
+		re, im, absRe, absIm := ttExactSum(xs)
+		abs := xmath.NewComplex64(float32(absRe), float32(absIm))
+
+		// Absolute error bounds: k1*|s| + k2*sum|x[i]|.
+		check := func(name string, have xmath.Complex64, k1, k2 float64) {
+			t.Helper()
+			errRe := math.Abs(float64(have.Real()) - re)
+			errIm := math.Abs(float64(have.Imag()) - im)
+			if errRe > k1*math.Abs(re)+k2*float64(abs.Real()) || errIm > k1*math.Abs(im)+k2*float64(abs.Imag()) {
+				t.Errorf("cond=%g: %s error is too big;\nwant: (%v, %v)\nhave: %v", cond, name, re, im, have)
+			}
+		}
+		n := float64(len(xs))
+		check("KahanSum", KahanSum(xs), 0, 2*u+2*n*u*u)
+		// As if summed with twice the working precision.
+		check("NeumaierSum", NeumaierSum(xs), u, n*n*u*u)
+		check("PairwiseSum", PairwiseSum(xs), 0, ttPairwiseBound(len(xs)))
+		t.Logf("cond=%g: naive %.1eu, pairwise %.1eu, kahan %.1eu, neumaier %.1eu", cond,
+			ttSumError(ttNaiveSum(xs), re, im)/u, ttSumError(PairwiseSum(xs), re, im)/u,
+			ttSumError(KahanSum(xs), re, im)/u, ttSumError(NeumaierSum(xs), re, im)/u)
+	}
+
+	// Kahan summation can't compensate summands
+	// that are bigger than the running sum.
+	xs := []xmath.Complex64{
+		xmath.NewComplex64(1, 1),
+		xmath.NewComplex64(1e10, -1e10),
+		xmath.NewComplex64(1, 1),
+		xmath.NewComplex64(-1e10, 1e10),
+	}
+	if have := NeumaierSum(xs); have != xmath.NewComplex64(2, 2) {
+		t.Errorf("NeumaierSum: want (2+2i), have %v", have)
+	}
+	if have := KahanSum(xs); have == xmath.NewComplex64(2, 2) {
+		t.Errorf("KahanSum is more precise than expected: %v", have)
+	}
+}
+
+func TestTwoProduct(t *testing.T) {
+	rng := rand.New(rand.NewPCG(48, 0))
+	inexact := 0
+	for i := 0; i < 1000; i++ {
+		a := float32(rng.NormFloat64())
+		b := float32(rng.NormFloat64() * 1e3)
+		p, e := twoProduct(a, b)
+		// float64 product of float32 values is exact.
+		if float64(p)+float64(e) != float64(a)*float64(b) {
+			t.Errorf("twoProduct(%v, %v) = %v, %v: not exact", a, b, p, e)
+		}
+		if e != 0 {
+			inexact++
+		}
+	}
+	if inexact == 0 {
+		t.Errorf("twoProduct: all error terms are zero")
+	}
+}
+
+func TestCompensatedDot(t *testing.T) {
+	const u = 0x1p-24
+	for _, cond := range []float32{1, 1e3, 1e6} {
+		const n = 5000
+		a := ttIllConditioned(uint64(cond)+10, n, cond)
+		b := make([]xmath.Complex64, n)
+		for i := range b {
+			// Products cancel each other like a values do.
+			b[i] = xmath.NewComplex64(1, 0)
+		}
+		copy(b, ttBlasInput(uint64(cond), n/10))
+
+		var re, im ttBigSum
+		var abs float64
+		for i := range a {
+			ar, ai := float64(a[i].Real()), float64(a[i].Imag())
+			br, bi := float64(b[i].Real()), float64(b[i].Imag())
+			re.addProduct(ar, br)
+			re.addProduct(-ai, bi)
+			im.addProduct(ar, bi)
+			im.addProduct(ai, br)
+			abs += math.Hypot(ar, ai) * math.Hypot(br, bi)
+		}
+		wantRe, wantIm := re.float64(), im.float64()
+
+		have := CompensatedDot(a, b)
+		// Dot2 error bound: u|s| + gamma(2n)^2 * sum|a[i]*b[i]|.
+		gamma := 4 * n * u
+		for _, p := range [][2]float64{{float64(have.Real()), wantRe}, {float64(have.Imag()), wantIm}} {
+			if math.Abs(p[0]-p[1]) > u*math.Abs(p[1])+gamma*gamma*abs {
+				t.Errorf("cond=%g: CompensatedDot error is too big;\nwant: %v\nhave: %v", cond, p[1], p[0])
+			}
+		}
+		naive := ttSumError(ttNaiveDot(a, b), wantRe, wantIm)
+		t.Logf("cond=%g: naive %.1eu, compensated %.1eu",
+			cond, naive/u, ttSumError(have, wantRe, wantIm)/u)
+	}
+
+	defer func() {
+		if r := recover(); r != errLength {
+			t.Errorf("expected %q panic, got %v", errLength, r)
+		}
+	}()
+	CompensatedDot(make([]xmath.Complex64, 2), make([]xmath.Complex64, 3))
+}
+
+// Performance tests.
+// Compare with BenchmarkSum, BenchmarkSumBuiltin and BenchmarkDotBuiltin results.
+
+func BenchmarkKahanSum(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = KahanSum(x) })
+}
+
+func BenchmarkNeumaierSum(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = NeumaierSum(x) })
+}
+
+func BenchmarkPairwiseSum(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = PairwiseSum(x) })
+}
+
+func BenchmarkCompensatedDot(b *testing.B) {
+	benchBlas(b, func(x, y []xmath.Complex64) { ttSink64 = CompensatedDot(x, y) })
+}
@@ -0,0 +1,125 @@
+// Package vec implements element-wise operations over Complex64 slices.
+//
+// Functions with "To" suffix store the result into dst and return it.
+// Other functions operate in-place, using dst as the first operand.
+//
+// All functions panic if the slice lengths are not equal.
+package vec
+
+import (
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+const errLength = "vec: slice length mismatch"
+
+// AddTo computes dst[i] = a[i] + b[i].
+func AddTo(dst, a, b []xmath.Complex64) []xmath.Complex64 {
+	if len(dst) != len(a) || len(dst) != len(b) {
+		panic(errLength)
+	}
+	kernels.add(dst, a, b)
+	return dst
+}
+
+// SubTo computes dst[i] = a[i] - b[i].
+func SubTo(dst, a, b []xmath.Complex64) []xmath.Complex64 {
+	if len(dst) != len(a) || len(dst) != len(b) {
+		panic(errLength)
+	}
+	for i := range dst {
+		dst[i] = a[i].Sub(b[i])
+	}
+	return dst
+}
+
+// MulTo computes dst[i] = a[i] * b[i].
+func MulTo(dst, a, b []xmath.Complex64) []xmath.Complex64 {
+	if len(dst) != len(a) || len(dst) != len(b) {
+		panic(errLength)
+	}
+	kernels.mul(dst, a, b)
+	return dst
+}
+
+// DivTo computes dst[i] = a[i] / b[i].
+func DivTo(dst, a, b []xmath.Complex64) []xmath.Complex64 {
+	if len(dst) != len(a) || len(dst) != len(b) {
+		panic(errLength)
+	}
+	for i := range dst {
+		dst[i] = a[i].Div(b[i])
+	}
+	return dst
+}
+
+// ScaleTo computes dst[i] = s * a[i].
+func ScaleTo(dst []xmath.Complex64, s xmath.Complex64, a []xmath.Complex64) []xmath.Complex64 {
+	if len(dst) != len(a) {
+		panic(errLength)
+	}
+	for i := range dst {
+		dst[i] = s.Mul(a[i])
+	}
+	return dst
+}
+
+// ConjTo computes dst[i] = conj(a[i]).
+func ConjTo(dst, a []xmath.Complex64) []xmath.Complex64 {
+	if len(dst) != len(a) {
+		panic(errLength)
+	}
+	for i := range dst {
+		dst[i] = a[i].Conj()
+	}
+	return dst
+}
+
+// Add computes dst[i] += a[i].
+func Add(dst, a []xmath.Complex64) {
+	if len(dst) != len(a) {
+		panic(errLength)
+	}
+	kernels.add(dst, dst, a)
+}
+
+// Sub computes dst[i] -= a[i].
+func Sub(dst, a []xmath.Complex64) {
+	if len(dst) != len(a) {
+		panic(errLength)
+	}
+	for i := range dst {
+		dst[i].SubAssign(a[i])
+	}
+}
+
+// Mul computes dst[i] *= a[i].
+func Mul(dst, a []xmath.Complex64) {
+	if len(dst) != len(a) {
+		panic(errLength)
+	}
+	kernels.mul(dst, dst, a)
+}
+
+// Div computes dst[i] /= a[i].
+func Div(dst, a []xmath.Complex64) {
+	if len(dst) != len(a) {
+		panic(errLength)
+	}
+	for i := range dst {
+		dst[i].DivAssign(a[i])
+	}
+}
+
+// Scale computes dst[i] *= s.
+func Scale(dst []xmath.Complex64, s xmath.Complex64) {
+	for i := range dst {
+		dst[i] = s.Mul(dst[i])
+	}
+}
+
+// Conj computes dst[i] = conj(dst[i]).
+func Conj(dst []xmath.Complex64) {
+	for i := range dst {
+		dst[i] = dst[i].Conj()
+	}
+}
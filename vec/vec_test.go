@@ -0,0 +1,269 @@
+package vec
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+	"github.com/Quasilyte/go-complex-nums-emulation/xrand"
+)
+
+// Helper functions.
+
+func ttToBuiltin(xs []xmath.Complex64) []complex64 {
+	res := make([]complex64, len(xs))
+	for i, x := range xs {
+		res[i] = complex(x.Real(), x.Imag())
+	}
+	return res
+}
+
+// ttSame reports whether x and y are bit-identical,
+// treating all NaN values as equal.
+func ttSame(x xmath.Complex64, y complex64) bool {
+	same := func(a, b float32) bool {
+		return math.Float32bits(a) == math.Float32bits(b) || (a != a && b != b)
+	}
+	return same(x.Real(), real(y)) && same(x.Imag(), imag(y))
+}
+
+// ttInput returns n pseudo-random values of various magnitudes,
+// including special values.
+func ttInput(seed uint64, n int) []xmath.Complex64 {
+	r := xrand.New(seed)
+	gens := []func() xmath.Complex64{
+		func() xmath.Complex64 { return r.Disk(1) },
+		func() xmath.Complex64 { return r.LogUniform(1e-30, 1e30) },
+		func() xmath.Complex64 {
+			return r.Box(xmath.NewComplex64(-1e7, -1e7), xmath.NewComplex64(1e7, 1e7))
+		},
+		r.Special,
+	}
+	xs := make([]xmath.Complex64, n)
+	for i := range xs {
+		xs[i] = gens[i%len(gens)]()
+	}
+	return xs
+}
+
+func ttCheck(t *testing.T, name string, have []xmath.Complex64, want []complex64) {
+	t.Helper()
+	for i := range want {
+		if !ttSame(have[i], want[i]) {
+			t.Errorf("%s: [%d] mismatch;\nwant: %v\nhave: %v", name, i, want[i], have[i])
+		}
+	}
+}
+
+// Unit tests.
+
+func TestBinaryOps(t *testing.T) {
+	const n = 1000
+	a := ttInput(1, n)
+	b := ttInput(2, n)
+	aBuiltin := ttToBuiltin(a)
+	bBuiltin := ttToBuiltin(b)
+
+	tests := []struct {
+		name      string
+		builtinOp func(x, y complex64) complex64
+		opTo      func(dst, a, b []xmath.Complex64) []xmath.Complex64
+		op        func(dst, a []xmath.Complex64)
+	}{
+		{"Add", func(x, y complex64) complex64 { return x + y }, AddTo, Add},
+		{"Sub", func(x, y complex64) complex64 { return x - y }, SubTo, Sub},
+		{"Mul", func(x, y complex64) complex64 { return x * y }, MulTo, Mul},
+		{"Div", func(x, y complex64) complex64 { return x / y }, DivTo, Div},
+	}
+
+	for _, tt := range tests {
+		want := make([]complex64, n)
+		for i := range want {
+			want[i] = tt.builtinOp(aBuiltin[i], bBuiltin[i])
+		}
+
+		dst := make([]xmath.Complex64, n)
+		ttCheck(t, tt.name+"To", tt.opTo(dst, a, b), want)
+
+		copy(dst, a)
+		tt.op(dst, b)
+		ttCheck(t, tt.name, dst, want)
+	}
+}
+
+func TestScale(t *testing.T) {
+	const n = 1000
+	a := ttInput(3, n)
+	aBuiltin := ttToBuiltin(a)
+	for _, s := range ttInput(4, 20) {
+		sBuiltin := complex(s.Real(), s.Imag())
+		want := make([]complex64, n)
+		for i := range want {
+			want[i] = sBuiltin * aBuiltin[i]
+		}
+
+		dst := make([]xmath.Complex64, n)
+		ttCheck(t, "ScaleTo", ScaleTo(dst, s, a), want)
+
+		copy(dst, a)
+		Scale(dst, s)
+		ttCheck(t, "Scale", dst, want)
+	}
+}
+
+func TestConj(t *testing.T) {
+	const n = 1000
+	a := ttInput(5, n)
+	want := ttToBuiltin(a)
+	for i, x := range want {
+		want[i] = complex(real(x), -imag(x))
+	}
+
+	dst := make([]xmath.Complex64, n)
+	ttCheck(t, "ConjTo", ConjTo(dst, a), want)
+
+	copy(dst, a)
+	Conj(dst)
+	ttCheck(t, "Conj", dst, want)
+}
+
+func TestLengthMismatch(t *testing.T) {
+	a := make([]xmath.Complex64, 4)
+	b := make([]xmath.Complex64, 5)
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"AddTo", func() { AddTo(a, a, b) }},
+		{"SubTo", func() { SubTo(b, a, a) }},
+		{"MulTo", func() { MulTo(a, b, a) }},
+		{"DivTo", func() { DivTo(a, a, b) }},
+		{"ScaleTo", func() { ScaleTo(a, xmath.Complex64{}, b) }},
+		{"ConjTo", func() { ConjTo(a, b) }},
+		{"Add", func() { Add(a, b) }},
+		{"Sub", func() { Sub(a, b) }},
+		{"Mul", func() { Mul(a, b) }},
+		{"Div", func() { Div(a, b) }},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r != errLength {
+					t.Errorf("%s: expected %q panic, got %v", tt.name, errLength, r)
+				}
+			}()
+			tt.fn()
+		}()
+	}
+}
+
+// Performance tests.
+
+var ttBenchSizes = []int{16, 1024, 64 * 1024, 1024 * 1024}
+
+func benchBinaryBuiltin(b *testing.B, op func(dst, x, y []complex64)) {
+	for _, n := range ttBenchSizes {
+		x := ttToBuiltin(ttInput(1, n))
+		y := ttToBuiltin(ttInput(2, n))
+		dst := make([]complex64, n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n) * 8)
+			for i := 0; i < b.N; i++ {
+				op(dst, x, y)
+			}
+		})
+	}
+}
+
+func benchBinary(b *testing.B, op func(dst, x, y []xmath.Complex64) []xmath.Complex64) {
+	for _, n := range ttBenchSizes {
+		x := ttInput(1, n)
+		y := ttInput(2, n)
+		dst := make([]xmath.Complex64, n)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n) * 8)
+			for i := 0; i < b.N; i++ {
+				op(dst, x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkAddToBuiltin(b *testing.B) {
+	benchBinaryBuiltin(b, func(dst, x, y []complex64) {
+		for i := range dst {
+			dst[i] = x[i] + y[i]
+		}
+	})
+}
+
+func BenchmarkAddTo(b *testing.B) {
+	benchBinary(b, AddTo)
+}
+
+func BenchmarkSubToBuiltin(b *testing.B) {
+	benchBinaryBuiltin(b, func(dst, x, y []complex64) {
+		for i := range dst {
+			dst[i] = x[i] - y[i]
+		}
+	})
+}
+
+func BenchmarkSubTo(b *testing.B) {
+	benchBinary(b, SubTo)
+}
+
+func BenchmarkMulToBuiltin(b *testing.B) {
+	benchBinaryBuiltin(b, func(dst, x, y []complex64) {
+		for i := range dst {
+			dst[i] = x[i] * y[i]
+		}
+	})
+}
+
+func BenchmarkMulTo(b *testing.B) {
+	benchBinary(b, MulTo)
+}
+
+func BenchmarkDivToBuiltin(b *testing.B) {
+	benchBinaryBuiltin(b, func(dst, x, y []complex64) {
+		for i := range dst {
+			dst[i] = x[i] / y[i]
+		}
+	})
+}
+
+func BenchmarkDivTo(b *testing.B) {
+	benchBinary(b, DivTo)
+}
+
+func BenchmarkScaleToBuiltin(b *testing.B) {
+	benchBinaryBuiltin(b, func(dst, x, y []complex64) {
+		s := y[0]
+		for i := range dst {
+			dst[i] = s * x[i]
+		}
+	})
+}
+
+func BenchmarkScaleTo(b *testing.B) {
+	benchBinary(b, func(dst, x, y []xmath.Complex64) []xmath.Complex64 {
+		return ScaleTo(dst, y[0], x)
+	})
+}
+
+func BenchmarkConjToBuiltin(b *testing.B) {
+	benchBinaryBuiltin(b, func(dst, x, y []complex64) {
+		for i := range dst {
+			dst[i] = complex(real(x[i]), -imag(x[i]))
+		}
+	})
+}
+
+func BenchmarkConjTo(b *testing.B) {
+	benchBinary(b, func(dst, x, y []xmath.Complex64) []xmath.Complex64 {
+		return ConjTo(dst, x)
+	})
+}
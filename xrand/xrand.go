@@ -0,0 +1,109 @@
+// Package xrand implements random Complex64 values generation.
+//
+// All generators are driven by a seeded math/rand/v2 source,
+// so the produced sequences are reproducible.
+package xrand
+
+import (
+	"math"
+	"math/rand/v2"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+// Rand is a source of random Complex64 values.
+type Rand struct {
+	rng *rand.Rand
+}
+
+// New returns a Rand that uses a PCG source initialized with seed.
+func New(seed uint64) *Rand {
+	return NewWithSource(rand.NewPCG(seed, 0))
+}
+
+// NewWithSource returns a Rand that uses src as the source of random bits.
+func NewWithSource(src rand.Source) *Rand {
+	return &Rand{rng: rand.New(src)}
+}
+
+// Box returns a value uniformly distributed inside the rectangle
+// with opposite corners lo and hi.
+func (r *Rand) Box(lo, hi xmath.Complex64) xmath.Complex64 {
+	return xmath.NewComplex64(
+		r.uniform(float64(lo.Real()), float64(hi.Real())),
+		r.uniform(float64(lo.Imag()), float64(hi.Imag())),
+	)
+}
+
+// Disk returns a value uniformly distributed inside the disk
+// with the specified radius centered at the origin.
+func (r *Rand) Disk(radius float32) xmath.Complex64 {
+	rho := float64(radius) * math.Sqrt(r.rng.Float64())
+	return r.polar(rho)
+}
+
+// LogUniform returns a value with uniformly distributed phase
+// and a magnitude that is log-uniformly distributed in [minAbs, maxAbs).
+//
+// This distribution covers several orders of magnitude evenly,
+// which is useful to test both tiny and huge values.
+// minAbs must be positive.
+func (r *Rand) LogUniform(minAbs, maxAbs float32) xmath.Complex64 {
+	lo := math.Log(float64(minAbs))
+	hi := math.Log(float64(maxAbs))
+	return r.polar(math.Exp(lo + (hi-lo)*r.rng.Float64()))
+}
+
+// Bits returns a value with both parts built from random bit patterns.
+//
+// Any float32 value can be produced, including NaNs,
+// infinities and subnormal numbers.
+func (r *Rand) Bits() xmath.Complex64 {
+	bits := r.rng.Uint64()
+	return xmath.NewComplex64(
+		math.Float32frombits(uint32(bits)),
+		math.Float32frombits(uint32(bits>>32)),
+	)
+}
+
+// Special returns a value with parts selected from
+// the SpecialParts list.
+func (r *Rand) Special() xmath.Complex64 {
+	return xmath.NewComplex64(
+		SpecialParts[r.rng.IntN(len(SpecialParts))],
+		SpecialParts[r.rng.IntN(len(SpecialParts))],
+	)
+}
+
+// SpecialParts contains float32 values that are likely
+// to trigger edge cases in complex arithmetic.
+var SpecialParts = []float32{
+	0,
+	float32(math.Copysign(0, -1)),
+	1,
+	-1,
+	math.SmallestNonzeroFloat32,
+	-math.SmallestNonzeroFloat32,
+	0x1p-126, // Smallest normal float32.
+	math.MaxFloat32,
+	-math.MaxFloat32,
+	float32(math.Inf(+1)),
+	float32(math.Inf(-1)),
+	float32(math.NaN()),
+}
+
+// Fill sets every dst element to the value returned by gen.
+func Fill(dst []xmath.Complex64, gen func() xmath.Complex64) {
+	for i := range dst {
+		dst[i] = gen()
+	}
+}
+
+func (r *Rand) uniform(lo, hi float64) float32 {
+	return float32(lo + (hi-lo)*r.rng.Float64())
+}
+
+func (r *Rand) polar(rho float64) xmath.Complex64 {
+	sin, cos := math.Sincos(2 * math.Pi * r.rng.Float64())
+	return xmath.NewComplex64(float32(rho*cos), float32(rho*sin))
+}
@@ -0,0 +1,125 @@
+package xrand
+
+import (
+	"math"
+	"testing"
+
+	xmath "github.com/Quasilyte/go-complex-nums-emulation"
+)
+
+func TestDeterministic(t *testing.T) {
+	gens := []struct {
+		name string
+		gen  func(r *Rand) xmath.Complex64
+	}{
+		{"Box", func(r *Rand) xmath.Complex64 {
+			return r.Box(xmath.NewComplex64(-1, -1), xmath.NewComplex64(1, 1))
+		}},
+		{"Disk", func(r *Rand) xmath.Complex64 { return r.Disk(10) }},
+		{"LogUniform", func(r *Rand) xmath.Complex64 { return r.LogUniform(1e-10, 1e10) }},
+		{"Bits", (*Rand).Bits},
+		{"Special", (*Rand).Special},
+	}
+
+	for _, tt := range gens {
+		r1 := New(42)
+		r2 := New(42)
+		for i := 0; i < 100; i++ {
+			x := tt.gen(r1)
+			y := tt.gen(r2)
+			if math.Float32bits(x.Real()) != math.Float32bits(y.Real()) ||
+				math.Float32bits(x.Imag()) != math.Float32bits(y.Imag()) {
+				t.Fatalf("%s: sequences with equal seeds differ at %d: %v vs %v",
+					tt.name, i, x, y)
+			}
+		}
+	}
+}
+
+func TestBox(t *testing.T) {
+	r := New(1)
+	lo := xmath.NewComplex64(-5, 100)
+	hi := xmath.NewComplex64(5, 200)
+	for i := 0; i < 1000; i++ {
+		x := r.Box(lo, hi)
+		if x.Real() < lo.Real() || x.Real() > hi.Real() ||
+			x.Imag() < lo.Imag() || x.Imag() > hi.Imag() {
+			t.Fatalf("%v is outside of [%v, %v] box", x, lo, hi)
+		}
+	}
+}
+
+func TestDisk(t *testing.T) {
+	r := New(2)
+	const radius = 3
+	for i := 0; i < 1000; i++ {
+		x := r.Disk(radius)
+		if abs := x.Abs(); abs > radius*(1+1e-6) {
+			t.Fatalf("%v is outside of the disk (abs=%v)", x, abs)
+		}
+	}
+}
+
+func TestLogUniform(t *testing.T) {
+	r := New(3)
+	const minAbs, maxAbs = 1e-20, 1e20
+	// Count values per decade to check that magnitudes
+	// are spread evenly over the whole range.
+	var decades [40]int
+	const n = 40000
+	for i := 0; i < n; i++ {
+		x := r.LogUniform(minAbs, maxAbs)
+		abs := float64(x.Abs())
+		if abs < minAbs*(1-1e-6) || abs > maxAbs*(1+1e-6) {
+			t.Fatalf("%v abs=%v is outside of [%v, %v)", x, abs, minAbs, maxAbs)
+		}
+		d := int(math.Floor(math.Log10(abs))) + 20
+		decades[min(max(d, 0), len(decades)-1)]++
+	}
+	for d, count := range decades {
+		if count < n/len(decades)/2 {
+			t.Errorf("decade 1e%d has only %d values", d-20, count)
+		}
+	}
+}
+
+func TestBits(t *testing.T) {
+	r := New(4)
+	nan := false
+	for i := 0; i < 10000 && !nan; i++ {
+		x := r.Bits()
+		nan = x.Real() != x.Real() || x.Imag() != x.Imag()
+	}
+	if !nan {
+		t.Errorf("no NaN values generated")
+	}
+}
+
+func TestSpecial(t *testing.T) {
+	r := New(5)
+	isSpecial := func(x float32) bool {
+		for _, v := range SpecialParts {
+			if math.Float32bits(v) == math.Float32bits(x) {
+				return true
+			}
+		}
+		return false
+	}
+	for i := 0; i < 1000; i++ {
+		x := r.Special()
+		if !isSpecial(x.Real()) || !isSpecial(x.Imag()) {
+			t.Fatalf("%v has non-special parts", x)
+		}
+	}
+}
+
+func TestFill(t *testing.T) {
+	r := New(6)
+	dst := make([]xmath.Complex64, 10)
+	Fill(dst, func() xmath.Complex64 { return r.Disk(1) })
+	for i, x := range dst {
+		if x.IsZero() {
+			t.Errorf("dst[%d] was not filled", i)
+		}
+	}
+}
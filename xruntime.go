@@ -4,9 +4,9 @@
 
 package xmath
 
-import "unsafe"
-
 // These functions are also copy/pasted from the Go runtime.
+// Bit conversions are defined in xruntime_unsafe.go
+// and xruntime_safe.go, depending on build tags.
 
 var inf = float64frombits(0x7FF0000000000000)
 
@@ -53,13 +53,19 @@ func inf2one(f float64) float64 {
 	return copysign(g, f)
 }
 
-// Float64bits returns the IEEE 754 binary representation of f.
-func float64bits(f float64) uint64 {
-	return *(*uint64)(unsafe.Pointer(&f))
+// abs32 is float32 version of abs.
+func abs32(x float32) float32 {
+	const sign = 1 << 31
+	return float32frombits(float32bits(x) &^ sign)
+}
+
+// isNaN32 is float32 version of isNaN.
+func isNaN32(f float32) bool {
+	return f != f
 }
 
-// Float64frombits returns the floating point number corresponding
-// the IEEE 754 binary representation b.
-func float64frombits(b uint64) float64 {
-	return *(*float64)(unsafe.Pointer(&b))
+// copysign32 is float32 version of copysign.
+func copysign32(x, y float32) float32 {
+	const sign = 1 << 31
+	return float32frombits(float32bits(x)&^sign | float32bits(y)&sign)
 }
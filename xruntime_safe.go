@@ -0,0 +1,17 @@
+//go:build purego || nounsafe
+
+package xmath
+
+import "math"
+
+// Safe versions of xruntime_unsafe.go functions,
+// for environments that forbid the unsafe package.
+// They compile to the same machine code.
+
+func float64bits(f float64) uint64 { return math.Float64bits(f) }
+
+func float64frombits(b uint64) float64 { return math.Float64frombits(b) }
+
+func float32bits(f float32) uint32 { return math.Float32bits(f) }
+
+func float32frombits(b uint32) float32 { return math.Float32frombits(b) }
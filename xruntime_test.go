@@ -0,0 +1,120 @@
+package xmath
+
+import (
+	"math"
+	"testing"
+)
+
+// Helper functions.
+
+// ttSpecials64 are float64 values that exercise sign,
+// exponent and mantissa edge cases.
+var ttSpecials64 = []float64{
+	0, math.Copysign(0, -1),
+	1, -1, 0.5, -2.75,
+	math.MaxFloat64, -math.MaxFloat64,
+	math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+	0x1p-1022, -0x1p-1022,
+	math.Inf(1), math.Inf(-1),
+	math.NaN(), -math.NaN(),
+	math.Float64frombits(0x7ff0000000000001), // Signaling NaN.
+}
+
+// ttSpecials32 are float32 counterparts of ttSpecials64.
+var ttSpecials32 = []float32{
+	0, float32(math.Copysign(0, -1)),
+	1, -1, 0.5, -2.75,
+	math.MaxFloat32, -math.MaxFloat32,
+	math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32,
+	0x1p-126, -0x1p-126,
+	float32(math.Inf(1)), float32(math.Inf(-1)),
+	float32(math.NaN()), -float32(math.NaN()),
+	math.Float32frombits(0x7f800001), // Signaling NaN.
+}
+
+// Unit tests.
+
+func TestBits(t *testing.T) {
+	for _, x := range ttSpecials64 {
+		want := math.Float64bits(x)
+		if have := float64bits(x); have != want {
+			t.Errorf("float64bits(%v) failed;\nwant: %#016x\nhave: %#016x", x, want, have)
+		}
+		if have := math.Float64bits(float64frombits(want)); have != want {
+			t.Errorf("float64frombits(%#016x) failed;\nwant: %#016x\nhave: %#016x", want, want, have)
+		}
+	}
+	for _, x := range ttSpecials32 {
+		want := math.Float32bits(x)
+		if have := float32bits(x); have != want {
+			t.Errorf("float32bits(%v) failed;\nwant: %#08x\nhave: %#08x", x, want, have)
+		}
+		if have := math.Float32bits(float32frombits(want)); have != want {
+			t.Errorf("float32frombits(%#08x) failed;\nwant: %#08x\nhave: %#08x", want, want, have)
+		}
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	for _, x := range ttSpecials64 {
+		if have, want := isNaN(x), math.IsNaN(x); have != want {
+			t.Errorf("isNaN(%v) failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+		if have, want := isInf(x), math.IsInf(x, 0); have != want {
+			t.Errorf("isInf(%v) failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+		want := !math.IsNaN(x) && !math.IsInf(x, 0)
+		if have := isFinite(x); have != want {
+			t.Errorf("isFinite(%v) failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+	for _, x := range ttSpecials32 {
+		if have, want := isNaN32(x), math.IsNaN(float64(x)); have != want {
+			t.Errorf("isNaN32(%v) failed;\nwant: %v\nhave: %v", x, want, have)
+		}
+	}
+}
+
+func TestSignOps(t *testing.T) {
+	for _, x := range ttSpecials64 {
+		want := math.Float64bits(math.Abs(x))
+		if have := math.Float64bits(abs(x)); have != want {
+			t.Errorf("abs(%v) failed;\nwant: %#016x\nhave: %#016x", x, want, have)
+		}
+		g := 0.0
+		if math.IsInf(x, 0) {
+			g = 1
+		}
+		want = math.Float64bits(math.Copysign(g, x))
+		if have := math.Float64bits(inf2one(x)); have != want {
+			t.Errorf("inf2one(%v) failed;\nwant: %#016x\nhave: %#016x", x, want, have)
+		}
+		for _, y := range ttSpecials64 {
+			want := math.Float64bits(math.Copysign(x, y))
+			if have := math.Float64bits(copysign(x, y)); have != want {
+				t.Errorf("copysign(%v, %v) failed;\nwant: %#016x\nhave: %#016x", x, y, want, have)
+			}
+		}
+	}
+	for _, x := range ttSpecials32 {
+		// Unlike conversions to float64 and back, bit operations
+		// keep NaN payloads, so the expected values are built from bits.
+		const sign = 1 << 31
+		want := math.Float32bits(x) &^ sign
+		if have := math.Float32bits(abs32(x)); have != want {
+			t.Errorf("abs32(%v) failed;\nwant: %#08x\nhave: %#08x", x, want, have)
+		}
+		if !isNaN32(x) && float64(abs32(x)) != math.Abs(float64(x)) {
+			t.Errorf("abs32(%v) differs from math.Abs", x)
+		}
+		for _, y := range ttSpecials32 {
+			want := math.Float32bits(x)&^sign | math.Float32bits(y)&sign
+			if have := math.Float32bits(copysign32(x, y)); have != want {
+				t.Errorf("copysign32(%v, %v) failed;\nwant: %#08x\nhave: %#08x", x, y, want, have)
+			}
+			if !isNaN32(x) && float64(copysign32(x, y)) != math.Copysign(float64(x), float64(y)) {
+				t.Errorf("copysign32(%v, %v) differs from math.Copysign", x, y)
+			}
+		}
+	}
+}
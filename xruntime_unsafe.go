@@ -0,0 +1,31 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego && !nounsafe
+
+package xmath
+
+import "unsafe"
+
+// Float64bits returns the IEEE 754 binary representation of f.
+func float64bits(f float64) uint64 {
+	return *(*uint64)(unsafe.Pointer(&f))
+}
+
+// Float64frombits returns the floating point number corresponding
+// the IEEE 754 binary representation b.
+func float64frombits(b uint64) float64 {
+	return *(*float64)(unsafe.Pointer(&b))
+}
+
+// Float32bits returns the IEEE 754 binary representation of f.
+func float32bits(f float32) uint32 {
+	return *(*uint32)(unsafe.Pointer(&f))
+}
+
+// Float32frombits returns the floating point number corresponding
+// the IEEE 754 binary representation b.
+func float32frombits(b uint32) float32 {
+	return *(*float32)(unsafe.Pointer(&b))
+}